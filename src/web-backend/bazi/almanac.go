@@ -0,0 +1,244 @@
+package bazi
+
+import (
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"sync"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// Daily almanac (黄历): 建除十二神, the lunar mansion on duty (值日星宿), 彭祖百忌,
+// the day's clash (冲煞) and the 宜/忌 activity lists. The activity lists come from
+// data/almanac.csv; see the header of that file for the format.
+
+//go:embed data/almanac.csv
+var almanacCSV string
+
+// Officer is one of the twelve day officers (建除十二神), 0=建 ... 11=闭.
+type Officer int
+
+var officerNames = [12]string{"建", "除", "满", "平", "定", "执", "破", "危", "成", "收", "开", "闭"}
+
+func (o Officer) String() string { return officerNames[mod(int(o), 12)] }
+
+// OfficerOf returns the officer of a day: 建 falls on the day whose branch is the
+// month branch, and the officers follow the days from there.
+func OfficerOf(month, day Branch) Officer { return Officer(mod(int(day)-int(month), 12)) }
+
+// Mansion is one of the 28 lunar mansions (二十八宿), 0=角 ... 27=轸.
+type Mansion int
+
+var mansions = [28]struct {
+	name, luminary, animal string
+	auspicious             bool
+}{
+	{"角", "木", "蛟", true}, {"亢", "金", "龙", false}, {"氐", "土", "貉", false}, {"房", "日", "兔", true},
+	{"心", "月", "狐", false}, {"尾", "火", "虎", true}, {"箕", "水", "豹", true}, {"斗", "木", "獬", true},
+	{"牛", "金", "牛", false}, {"女", "土", "蝠", false}, {"虚", "日", "鼠", false}, {"危", "月", "燕", false},
+	{"室", "火", "猪", true}, {"壁", "水", "貐", true}, {"奎", "木", "狼", false}, {"娄", "金", "狗", true},
+	{"胃", "土", "雉", true}, {"昴", "日", "鸡", false}, {"毕", "月", "乌", true}, {"觜", "火", "猴", false},
+	{"参", "水", "猿", true}, {"井", "木", "犴", true}, {"鬼", "金", "羊", false}, {"柳", "土", "獐", false},
+	{"星", "日", "马", false}, {"张", "月", "鹿", true}, {"翼", "火", "蛇", false}, {"轸", "水", "蚓", true},
+}
+
+func (m Mansion) String() string { return mansions[mod(int(m), 28)].name }
+
+// FullName spells the mansion with its luminary (七曜) and animal, e.g. "角木蛟".
+func (m Mansion) FullName() string {
+	x := mansions[mod(int(m), 28)]
+	return x.name + x.luminary + x.animal
+}
+
+// Auspicious reports the traditional 吉/凶 of the mansion.
+func (m Mansion) Auspicious() bool { return mansions[mod(int(m), 28)].auspicious }
+
+// MansionOf returns the mansion on duty on a date. The mansions follow the days in
+// an unbroken 28-day cycle locked to the week: 角 always falls on a Thursday.
+func MansionOf(d time.Time) Mansion {
+	return Mansion(mod(julianDayNumber(d.Year(), d.Month(), d.Day())+11, 28))
+}
+
+var pengZuStem = [10]string{
+	"甲不开仓财物耗散", "乙不栽植千株不长", "丙不修灶必见灾殃", "丁不剃头头必生疮", "戊不受田田主不祥",
+	"己不破券二比并亡", "庚不经络织机虚张", "辛不合酱主人不尝", "壬不汲水更难提防", "癸不词讼理弱敌强",
+}
+
+var pengZuBranch = [12]string{
+	"子不问卜自惹祸殃", "丑不冠带主不还乡", "寅不祭祀神鬼不尝", "卯不穿井水泉不香", "辰不哭泣必主重丧", "巳不远行财物伏藏",
+	"午不苫盖屋主更张", "未不服药毒气入肠", "申不安床鬼祟入房", "酉不会客醉坐颠狂", "戌不吃犬作怪上床", "亥不嫁娶不利新郎",
+}
+
+// shaDirections gives the 煞方 by the day branch's 三合 frame: 申子辰煞南, 亥卯未煞西,
+// 寅午戌煞北, 巳酉丑煞东.
+var shaDirections = [12]string{"南", "东", "北", "西", "南", "东", "北", "西", "南", "东", "北", "西"}
+
+// Almanac is the almanac of one day.
+type Almanac struct {
+	CalendarDay
+	Officer Officer
+	Mansion Mansion
+	// PengZu holds the 彭祖百忌 of the day stem and of the day branch.
+	PengZu [2]string
+	// Clash is the pillar the day clashes (冲), e.g. 戊午 for 甲子; Sha the 煞方.
+	Clash Pillar
+	Sha   string
+	// YearClash is true when the day branch clashes the year branch (岁破).
+	YearClash bool
+	Yi, Ji    []string
+}
+
+type almanacRule struct {
+	kind, key string
+	yi, ji    []string
+}
+
+var loadAlmanacRules = sync.OnceValues(func() ([]almanacRule, error) {
+	r := csv.NewReader(strings.NewReader(almanacCSV))
+	r.Comment = '#'
+	r.FieldsPerRecord = 4
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("almanac: %w", err)
+	}
+	var rules []almanacRule
+	for i, rec := range records {
+		if i == 0 {
+			continue // header
+		}
+		rule := almanacRule{kind: strings.TrimSpace(rec[0]), key: strings.TrimSpace(rec[1]),
+			yi: strings.Fields(rec[2]), ji: strings.Fields(rec[3])}
+		switch rule.kind {
+		case "officer", "day_stem", "day_branch", "year_clash":
+		default:
+			return nil, fmt.Errorf("almanac: %s: unknown kind %q", rule.key, rule.kind)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+})
+
+// AlmanacOf computes the almanac of a date (its Beijing calendar date is used).
+func AlmanacOf(date time.Time) (Almanac, error) {
+	b := date.In(beijing)
+	return almanacOf(time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, beijing), solarTermsAround(b.Year()))
+}
+
+// almanacOf computes the almanac of the day d (midnight, Beijing time) from terms, as
+// for calendarDayOf.
+func almanacOf(d time.Time, terms []SolarTermEvent) (Almanac, error) {
+	cd, err := calendarDayOf(d, terms)
+	if err != nil {
+		return Almanac{}, err
+	}
+	cd.InMonth = true
+	a := Almanac{
+		CalendarDay: cd,
+		Officer:     OfficerOf(cd.Month.Branch, cd.Day.Branch),
+		Mansion:     MansionOf(d),
+		PengZu:      [2]string{pengZuStem[cd.Day.Stem], pengZuBranch[cd.Day.Branch]},
+		Clash:       Pillar{Stem: cd.Day.Stem + 4, Branch: cd.Day.Branch + 6},
+		Sha:         shaDirections[cd.Day.Branch],
+		YearClash:   mod(int(cd.Day.Branch)-int(cd.Year.Branch), 12) == 6,
+	}
+	a.Clash = PillarFromIndex(a.Clash.Index())
+
+	rules, err := loadAlmanacRules()
+	if err != nil {
+		return Almanac{}, err
+	}
+	var yi, ji []string
+	for _, r := range rules {
+		var hit bool
+		switch r.kind {
+		case "officer":
+			hit = r.key == a.Officer.String()
+		case "day_stem":
+			hit = r.key == cd.Day.Stem.String()
+		case "day_branch":
+			hit = r.key == cd.Day.Branch.String()
+		case "year_clash":
+			hit = a.YearClash
+		}
+		if hit {
+			yi, ji = append(yi, r.yi...), append(ji, r.ji...)
+		}
+	}
+	ji = uniqueStrings(ji)
+	for _, act := range uniqueStrings(yi) {
+		if !containsString(ji, act) {
+			a.Yi = append(a.Yi, act)
+		}
+	}
+	a.Ji = ji
+	return a, nil
+}
+
+func uniqueStrings(ss []string) []string {
+	var out []string
+	for _, s := range ss {
+		if !containsString(out, s) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+// AlmanacDay is the backend handler for "/almanac/day?date=YYYY-MM-DD" (default today,
+// Beijing time).
+func AlmanacDay(ctx context.Context, req *pb.AlmanacDayRequest) (*pb.AlmanacDayResponse, error) {
+	date := time.Now()
+	if s := strings.TrimSpace(req.GetDate()); s != "" {
+		t, err := time.ParseInLocation("2006-01-02", s, beijing)
+		if err != nil {
+			return &pb.AlmanacDayResponse{Code: 1002, Message: "日期格式不正确，应为 YYYY-MM-DD"}, nil
+		}
+		date = t
+	}
+	if y := date.In(beijing).Year(); y < calendarMinYear || y > calendarMaxYear {
+		return &pb.AlmanacDayResponse{
+			Code:    1002,
+			Message: fmt.Sprintf("日期不合法，支持 %d 至 %d 年", calendarMinYear, calendarMaxYear),
+		}, nil
+	}
+
+	a, err := AlmanacOf(date)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.AlmanacDayResponse{
+		Code:              0,
+		Message:           "ok",
+		Day:               calendarDayInfo(a.CalendarDay),
+		Officer:           a.Officer.String(),
+		Mansion:           a.Mansion.FullName(),
+		MansionAuspicious: a.Mansion.Auspicious(),
+		PengZu:            a.PengZu[:],
+		Clash:             fmt.Sprintf("冲%s（%s）", a.Clash.Branch.Zodiac(), a.Clash),
+		Sha:               "煞" + a.Sha,
+		YearClash:         a.YearClash,
+		Yi:                a.Yi,
+		Ji:                a.Ji,
+		Etag:              AlmanacETag(a.Date),
+	}
+	return resp, nil
+}
+
+// AlmanacETag identifies the almanac of a date; it changes with the rule table.
+func AlmanacETag(d time.Time) string {
+	return fmt.Sprintf(`"alm%d-%08x-%s"`, calendarVersion, crc32.ChecksumIEEE([]byte(almanacCSV)), d.Format("20060102"))
+}
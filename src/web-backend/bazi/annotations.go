@@ -0,0 +1,85 @@
+package bazi
+
+// Per-pillar annotations: 纳音, 十二长生, 空亡 and 神煞.
+
+// naYinNames lists the 纳音 of the sexagenary cycle, one per pair of pillars
+// (甲子乙丑 海中金, 丙寅丁卯 炉中火, ...).
+var naYinNames = [30]string{
+	"海中金", "炉中火", "大林木", "路旁土", "剑锋金", "山头火",
+	"涧下水", "城头土", "白蜡金", "杨柳木", "泉中水", "屋上土",
+	"霹雳火", "松柏木", "长流水", "沙中金", "山下火", "平地木",
+	"壁上土", "金箔金", "覆灯火", "天河水", "大驿土", "钗钏金",
+	"桑柘木", "大溪水", "沙中土", "天上火", "石榴木", "大海水",
+}
+
+// NaYin returns the pillar's 纳音, e.g. "海中金" for 甲子.
+func (p Pillar) NaYin() string { return naYinNames[p.Index()/2] }
+
+// LifeStage is one of the twelve stages of a stem's life cycle (十二长生).
+type LifeStage int
+
+var lifeStageNames = [12]string{"长生", "沐浴", "冠带", "临官", "帝旺", "衰", "病", "死", "墓", "绝", "胎", "养"}
+
+func (s LifeStage) String() string { return lifeStageNames[s] }
+
+// lifeBirthBranch is where each stem's 长生 falls: 甲亥 乙午 丙戊寅 丁己酉 庚巳 辛子 壬申 癸卯.
+var lifeBirthBranch = [10]Branch{11, 6, 2, 9, 2, 9, 5, 0, 8, 3}
+
+// LifeStageOf returns the stage of stem s at branch b. Yang stems advance through
+// the branches, yin stems go backwards.
+func LifeStageOf(s Stem, b Branch) LifeStage {
+	start := int(lifeBirthBranch[mod(int(s), 10)])
+	if s.IsYang() {
+		return LifeStage(mod(int(b)-start, 12))
+	}
+	return LifeStage(mod(start-int(b), 12))
+}
+
+// VoidBranches returns the two branches left out of p's decade (旬空 / 空亡), e.g.
+// 戌亥 for the 甲子 decade.
+func VoidBranches(p Pillar) [2]Branch {
+	first := mod(int(p.Branch)-int(p.Stem), 12) // branch paired with 甲 in this decade
+	return [2]Branch{Branch(mod(first+10, 12)), Branch(mod(first+11, 12))}
+}
+
+// PillarAnnotation holds the annotations of one pillar.
+type PillarAnnotation struct {
+	Position Position
+	Pillar   Pillar
+	NaYin    string
+	// Stage is the day master's stage at this branch (星运); SelfStage is the
+	// pillar's own stem at its branch (自坐).
+	Stage     LifeStage
+	SelfStage LifeStage
+	// Void: the branch is void by the day pillar's decade; YearVoid: by the year's.
+	Void     bool
+	YearVoid bool
+	Stars    []StarHit
+}
+
+// AnnotatePillars annotates the natal pillars and any extra (luck/annual) pillars.
+// The 神煞 are looked up from the natal chart; an error means the embedded rule table
+// is malformed.
+func AnnotatePillars(p FourPillars, extra ...PlacedPillar) ([]PillarAnnotation, error) {
+	rules, err := loadShenSha()
+	if err != nil {
+		return nil, err
+	}
+	placed := append(p.Placed(), extra...)
+	dayVoid, yearVoid := VoidBranches(p.Day), VoidBranches(p.Year)
+	out := make([]PillarAnnotation, 0, len(placed))
+	for _, pp := range placed {
+		b := pp.Pillar.Branch
+		out = append(out, PillarAnnotation{
+			Position:  pp.Position,
+			Pillar:    pp.Pillar,
+			NaYin:     pp.Pillar.NaYin(),
+			Stage:     LifeStageOf(p.Day.Stem, b),
+			SelfStage: LifeStageOf(pp.Pillar.Stem, b),
+			Void:      b == dayVoid[0] || b == dayVoid[1],
+			YearVoid:  b == yearVoid[0] || b == yearVoid[1],
+			Stars:     rules.match(p, pp),
+		})
+	}
+	return out, nil
+}
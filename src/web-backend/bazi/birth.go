@@ -0,0 +1,254 @@
+package bazi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// birth is a birth moment resolved from the ReasoningRequest inputs: calendar
+// conversion, time zone, longitude, civil time rules and true solar time.
+type birth struct {
+	solarDate     string // "YYYY-MM-DD", converted from lunar input if needed
+	inputCalendar string // "solar" | "lunar"
+	birthTime     string // "HH:mm"; the middle of the range when the time is uncertain
+	timeMode      string // "exact" | "range" | "unknown"
+	timeFrom      int    // uncertain time range, minutes after midnight (inclusive)
+	timeTo        int
+	country       string
+	loc           *time.Location // nil: China's historical rules
+	province      string
+	city          string
+	district      string
+
+	geo    GeoResult
+	lonErr error // non-nil: longitude unresolved, true solar time falls back to zone standard time
+
+	civil     time.Time // the birth instant
+	civilRule CivilTimeRule
+	trueSolar time.Time // local apparent solar time (wall clock in a zone named "LAT")
+
+	dayBoundary DayBoundary
+	pillars     FourPillars
+	// candidates splits an uncertain time range by chart; nil for an exact time.
+	candidates []HourCandidate
+}
+
+func (b *birth) lonOK() bool { return b.lonErr == nil }
+
+// solarTimeOf converts a birth instant to local true solar time; without a longitude
+// it falls back to the zone's standard time.
+func (b *birth) solarTimeOf(t time.Time, rule CivilTimeRule) time.Time {
+	if b.lonOK() {
+		return trueSolarTime(t, b.geo.Longitude)
+	}
+	return t.In(time.FixedZone("", int(rule.StandardOffset()/time.Second)))
+}
+
+// zoneName is the IANA name, or empty when China's historical rules applied.
+func (b *birth) zoneName() string {
+	if b.loc == nil {
+		return ""
+	}
+	return b.loc.String()
+}
+
+// inputError is a validation failure; its message is shown to the user as is.
+type inputError struct{ msg string }
+
+func (e *inputError) Error() string { return e.msg }
+
+// resolveBirth validates req and resolves the birth moment. All errors are
+// *inputError; a failed geocode is not an error but recorded in lonErr.
+func resolveBirth(ctx context.Context, req *pb.ReasoningRequest) (*birth, error) {
+	if req == nil {
+		return nil, &inputError{"参数不合法"}
+	}
+	b := &birth{
+		solarDate:     req.GetSolarDate(),
+		inputCalendar: "solar",
+		birthTime:     req.GetBirthTime(),
+		province:      req.GetProvince(),
+		city:          req.GetCity(),
+		district:      req.GetDistrict(),
+		country:       normalizeCountry(req.GetCountry()),
+	}
+
+	// Resolve the Gregorian birth date; lunar input is converted first.
+	if req.GetLunarDate() != "" {
+		if b.solarDate != "" {
+			return nil, &inputError{"公历日期与农历日期只能填写一个"}
+		}
+		ld, err := parseLunarDate(req.GetLunarDate(), req.GetIsLeapMonth())
+		if err == nil {
+			var d time.Time
+			if d, err = LunarToSolar(ld); err == nil {
+				b.solarDate = d.Format("2006-01-02")
+			}
+		}
+		if err != nil {
+			return nil, &inputError{"农历日期不合法"}
+		}
+		b.inputCalendar = "lunar"
+	}
+
+	// Birth time: exact, a range within the day, or unknown (the whole day).
+	b.timeMode = "exact"
+	ranged := req.GetBirthTimeFrom() != "" || req.GetBirthTimeTo() != ""
+	if ranged || req.GetBirthTimeUnknown() {
+		if b.birthTime != "" || (ranged && req.GetBirthTimeUnknown()) {
+			return nil, &inputError{"出生时间、时间范围与时间不详只能选择一种"}
+		}
+		b.timeMode, b.timeFrom, b.timeTo = "unknown", 0, 24*60-1
+		if ranged {
+			from, ok1 := parseClockMinutes(req.GetBirthTimeFrom())
+			to, ok2 := parseClockMinutes(req.GetBirthTimeTo())
+			if !ok1 || !ok2 || to < from {
+				return nil, &inputError{"出生时间范围不合法，应为同一天内的 HH:mm 至 HH:mm"}
+			}
+			b.timeMode, b.timeFrom, b.timeTo = "range", from, to
+		}
+		b.birthTime = clockString((b.timeFrom + b.timeTo) / 2)
+	}
+
+	if _, err := parseBeijingTime(b.solarDate, b.birthTime); err != nil {
+		return nil, &inputError{"出生日期或时间格式不正确"}
+	}
+
+	loc, err := ResolveTimeZone(b.country, req.GetTimeZone())
+	if err != nil {
+		return nil, &inputError{"无法确定出生地时区，请填写 time_zone"}
+	}
+	b.loc = loc
+
+	// Explicit coordinates bypass geocoding.
+	switch {
+	case req.Longitude != nil:
+		lon, lat := req.GetLongitude(), req.GetLatitude()
+		if math.IsNaN(lon) || lon < -180 || lon > 180 || math.IsNaN(lat) || lat < -90 || lat > 90 {
+			return nil, &inputError{"经纬度不合法"}
+		}
+		b.geo = GeoResult{Longitude: lon, Latitude: lat, Provider: "input", Level: "input"}
+	case req.Latitude != nil:
+		return nil, &inputError{"填写纬度时须同时填写经度"}
+	default:
+		b.geo, b.lonErr = ResolveCityLongitude(ctx, GeoQuery{Country: b.country, Province: b.province, City: b.city, District: b.district})
+	}
+
+	// The clock reading follows the civil time of its era and place: China's historical
+	// rules (regional zones, summer time) or the IANA zone's history elsewhere.
+	civilAt := func(clock string) (time.Time, CivilTimeRule, error) {
+		if loc == nil {
+			return ChinaCivilTime(b.solarDate, clock, b.province, b.geo.Longitude, b.lonOK())
+		}
+		return ZoneCivilTime(b.solarDate, clock, loc)
+	}
+	b.civil, b.civilRule, err = civilAt(b.birthTime)
+	if err != nil {
+		return nil, &inputError{"出生日期或时间格式不正确"}
+	}
+	b.trueSolar = b.solarTimeOf(b.civil, b.civilRule)
+
+	db, ok := dayBoundaryFromProto(req.GetDayBoundary())
+	if !ok {
+		return nil, &inputError{"子时换日方式不合法"}
+	}
+	b.dayBoundary = db
+	b.pillars = ComputePillars(b.civil, b.trueSolar, b.dayBoundary)
+	if b.timeMode != "exact" {
+		b.candidates = b.hourCandidates(civilAt)
+	}
+	return b, nil
+}
+
+// dayBoundaryFromProto maps the request enum to a DayBoundary; unspecified means the
+// server default.
+func dayBoundaryFromProto(v pb.DayBoundary) (DayBoundary, bool) {
+	switch v {
+	case pb.DayBoundary_DAY_BOUNDARY_UNSPECIFIED:
+		return DefaultDayBoundary(), true
+	case pb.DayBoundary_DAY_BOUNDARY_ZI_INITIAL:
+		return ZiInitial, true
+	case pb.DayBoundary_DAY_BOUNDARY_SPLIT_ZI:
+		return SplitZi, true
+	case pb.DayBoundary_DAY_BOUNDARY_MIDNIGHT:
+		return Midnight, true
+	}
+	return 0, false
+}
+
+// Birth is a birth resolved as for /admin/reasoning, for the charts of other systems
+// (e.g. 紫微斗数) that start from the lunar date and the 时辰.
+type Birth struct {
+	// Hours holds one entry for an exact time; for an uncertain time, one per lunar
+	// date and 时辰 the range covers, in time order.
+	Hours []LunarHour
+	// Approximate: the birth time is a range or unknown.
+	Approximate bool
+	SolarTime   *pb.SolarTimeInfo
+}
+
+// LunarHour is a lunar birth date and 时辰, both by true solar time; 23:00–24:00 counts
+// as the next day under the 子初换日 convention.
+type LunarHour struct {
+	Lunar LunarDate
+	Hour  Branch
+	// From and To bound the input clock times ("HH:mm", inclusive) that give this
+	// date and hour; empty for an exact time.
+	From, To string
+}
+
+// ResolveBirth validates req and resolves the birth. Errors are validation failures
+// whose message can be shown to the user as is.
+func ResolveBirth(ctx context.Context, req *pb.ReasoningRequest) (*Birth, error) {
+	b, err := resolveBirth(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	lunarHour := func(solar time.Time, hour Branch) (LunarHour, error) {
+		if solar.Hour() == 23 && b.dayBoundary == ZiInitial {
+			solar = solar.AddDate(0, 0, 1)
+		}
+		ld, err := SolarToLunar(solar)
+		if err != nil {
+			return LunarHour{}, &inputError{fmt.Sprintf("出生日期超出农历历表范围（%d–%d）", lunarMinYear, lunarMaxYear)}
+		}
+		return LunarHour{Lunar: ld, Hour: hour}, nil
+	}
+
+	var hours []LunarHour
+	if b.timeMode == "exact" {
+		lh, err := lunarHour(b.trueSolar, b.pillars.Hour.Branch)
+		if err != nil {
+			return nil, err
+		}
+		hours = append(hours, lh)
+	}
+	// Each candidate has a single chart, so a single day and hour.
+	for _, c := range b.candidates {
+		lh, err := lunarHour(c.SolarFrom, c.Pillars.Hour.Branch)
+		if err != nil {
+			return nil, err
+		}
+		from, to := c.CivilFrom.Format("15:04"), c.CivilTo.Format("15:04")
+		if n := len(hours); n > 0 && hours[n-1].Lunar == lh.Lunar && hours[n-1].Hour == lh.Hour {
+			hours[n-1].To = to
+			continue
+		}
+		lh.From, lh.To = from, to
+		hours = append(hours, lh)
+	}
+
+	var geoErr string
+	if !b.lonOK() {
+		geoErr = "geo_failed: " + b.lonErr.Error()
+	}
+	return &Birth{
+		Hours:       hours,
+		Approximate: b.timeMode != "exact",
+		SolarTime:   solarTimeInfo(b, geoErr),
+	}, nil
+}
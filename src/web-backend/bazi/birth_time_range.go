@@ -0,0 +1,67 @@
+package bazi
+
+import (
+	"fmt"
+	"time"
+)
+
+// Uncertain birth times: a clock range is split into the windows that give the same
+// chart, which in practice means one window per candidate hour pillar (plus a split
+// wherever the day or month pillar changes inside the range).
+
+// HourCandidate is one possible chart within an uncertain birth time range.
+type HourCandidate struct {
+	Pillars FourPillars
+	// First and last minute of the window, as clock time and as true solar time.
+	CivilFrom, CivilTo time.Time
+	SolarFrom, SolarTo time.Time
+}
+
+// hourCandidates evaluates the chart at every minute of the range and merges runs of
+// equal charts. civilAt maps a clock reading "HH:mm" on the birth date to its instant.
+func (b *birth) hourCandidates(civilAt func(clock string) (time.Time, CivilTimeRule, error)) []HourCandidate {
+	var out []HourCandidate
+	for m := b.timeFrom; m <= b.timeTo; m++ {
+		t, rule, err := civilAt(clockString(m))
+		if err != nil {
+			continue
+		}
+		tst := b.solarTimeOf(t, rule)
+		fp := ComputePillars(t, tst, b.dayBoundary)
+		if n := len(out); n > 0 && out[n-1].Pillars == fp {
+			out[n-1].CivilTo, out[n-1].SolarTo = t, tst
+			continue
+		}
+		out = append(out, HourCandidate{Pillars: fp, CivilFrom: t, CivilTo: t, SolarFrom: tst, SolarTo: tst})
+	}
+	return out
+}
+
+// commonPillars returns the pillars shared by all candidates; known[i] is false for
+// the pillars (in year, month, day, hour order) that differ between them.
+func commonPillars(cs []HourCandidate) (fp FourPillars, known [4]bool) {
+	if len(cs) == 0 {
+		return fp, known
+	}
+	first := cs[0].Pillars.pillars()
+	known = [4]bool{true, true, true, true}
+	for _, c := range cs[1:] {
+		for i, pl := range c.Pillars.pillars() {
+			if pl != first[i] {
+				known[i] = false
+			}
+		}
+	}
+	return cs[0].Pillars, known
+}
+
+// parseClockMinutes parses "HH:mm" into minutes after midnight.
+func parseClockMinutes(s string) (int, bool) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, false
+	}
+	return t.Hour()*60 + t.Minute(), true
+}
+
+func clockString(minutes int) string { return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60) }
@@ -0,0 +1,180 @@
+package bazi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// 万年历: a month grid of days with their lunar dates, pillars and solar terms.
+//
+// Day-level pillars follow the almanac convention: the day of a 节 already belongs to
+// the new month (and the day of 立春 to the new year), whatever the hour of the term.
+// Charts use the exact instant instead (see ComputePillars).
+
+// calendarVersion is part of the calendar ETag; bump it whenever the content for a
+// given month changes (rules, data tables, ephemeris).
+const calendarVersion = 1
+
+// Supported years: the lunar table (1900..2100) less a year on each side for the
+// padding days.
+const (
+	calendarMinYear = lunarMinYear + 1
+	calendarMaxYear = lunarMaxYear - 1
+)
+
+// CalendarDay is one cell of the month grid.
+type CalendarDay struct {
+	Date    time.Time // midnight, Beijing time
+	InMonth bool      // false for the neighbouring months' days padding the grid
+	Lunar   LunarDate
+	Year    Pillar
+	Month   Pillar
+	Day     Pillar
+	// Term is the solar term falling on this day, if any.
+	Term    SolarTermEvent
+	HasTerm bool
+}
+
+// MonthGrid returns the days of a Gregorian month padded to whole weeks starting on
+// Monday. Dates are Beijing dates.
+func MonthGrid(year int, month time.Month) ([]CalendarDay, error) {
+	if year < calendarMinYear || year > calendarMaxYear || month < time.January || month > time.December {
+		return nil, fmt.Errorf("month %04d-%02d out of calendar range", year, month)
+	}
+	first := time.Date(year, month, 1, 0, 0, 0, 0, beijing)
+	start := first.AddDate(0, 0, -mod(int(first.Weekday())-1, 7))
+	last := first.AddDate(0, 1, -1)
+	end := last.AddDate(0, 0, 7-mod(int(last.Weekday())-1, 7)) // exclusive
+
+	// Terms of the surrounding years cover the padding and the 节 before the month.
+	terms := solarTermsAround(year)
+	var out []CalendarDay
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		cd, err := calendarDayOf(d, terms)
+		if err != nil {
+			return nil, err
+		}
+		cd.InMonth = d.Month() == month
+		out = append(out, cd)
+	}
+	return out, nil
+}
+
+// solarTermsAround returns the solar terms of the years before, of and after year.
+func solarTermsAround(year int) []SolarTermEvent {
+	return solarTermsBetween(year-1, year+1)
+}
+
+// solarTermsBetween returns the solar terms of the years from..to inclusive.
+func solarTermsBetween(from, to int) []SolarTermEvent {
+	var terms []SolarTermEvent
+	for y := from; y <= to; y++ {
+		terms = append(terms, SolarTermsOfYear(y)...)
+	}
+	return terms
+}
+
+// calendarDayOf fills in the day d (midnight, Beijing time) from terms, the
+// solarTermsAround d's year or a neighbouring one.
+func calendarDayOf(d time.Time, terms []SolarTermEvent) (CalendarDay, error) {
+	cd := CalendarDay{Date: d}
+	lunar, err := SolarToLunar(d)
+	if err != nil {
+		return cd, err
+	}
+	cd.Lunar = lunar
+
+	// The last 节 on or before this day sets the month; 立春 sets the year.
+	dateOf := func(t time.Time) time.Time {
+		b := t.In(beijing)
+		return time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, beijing)
+	}
+	var jie SolarTermEvent
+	y := d.Year()
+	for _, ev := range terms {
+		td := dateOf(ev.Time)
+		if ev.Term == 2 && td.Year() == d.Year() && d.Before(td) { // before 立春
+			y = d.Year() - 1
+		}
+		if td.After(d) {
+			continue
+		}
+		if td.Equal(d) {
+			cd.Term, cd.HasTerm = ev, true
+		}
+		if ev.Term.IsJie() {
+			jie = ev
+		}
+	}
+	cd.Year = PillarFromIndex(y - 4)
+	cd.Month = monthPillar(cd.Year.Stem, mod(int(jie.Term)/2-1, 12))
+	cd.Day = PillarFromIndex(julianDayNumber(d.Year(), d.Month(), d.Day()) + 49)
+	return cd, nil
+}
+
+// CalendarETag identifies the content of a month grid.
+func CalendarETag(year int, month time.Month) string {
+	return fmt.Sprintf(`"cal%d-%04d%02d"`, calendarVersion, year, int(month))
+}
+
+// Calendar is the backend handler for "/bazi/calendar?year=&month=".
+func Calendar(ctx context.Context, req *pb.CalendarRequest) (*pb.CalendarResponse, error) {
+	year, month := int(req.GetYear()), time.Month(req.GetMonth())
+	if year < calendarMinYear || year > calendarMaxYear || month < time.January || month > time.December {
+		return &pb.CalendarResponse{
+			Code:    1002,
+			Message: fmt.Sprintf("年月不合法，支持 %d 至 %d 年", calendarMinYear, calendarMaxYear),
+		}, nil
+	}
+	days, err := MonthGrid(year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.CalendarResponse{
+		Code:    0,
+		Message: "ok",
+		Year:    int32(year),
+		Month:   int32(month),
+		Etag:    CalendarETag(year, month),
+	}
+	for _, d := range days {
+		resp.Days = append(resp.Days, calendarDayInfo(d))
+	}
+	return resp, nil
+}
+
+func calendarDayInfo(d CalendarDay) *pb.CalendarDay {
+	monthName := lunarMonthNames[d.Lunar.Month-1] + "月"
+	if d.Lunar.IsLeapMonth {
+		monthName = "闰" + monthName
+	}
+	info := &pb.CalendarDay{
+		Date:           d.Date.Format("2006-01-02"),
+		Weekday:        int32(d.Date.Weekday()),
+		InMonth:        d.InMonth,
+		LunarYear:      int32(d.Lunar.Year),
+		LunarMonth:     int32(d.Lunar.Month),
+		LunarDay:       int32(d.Lunar.Day),
+		IsLeapMonth:    d.Lunar.IsLeapMonth,
+		LunarMonthName: monthName,
+		LunarDayName:   lunarDayName(d.Lunar.Day),
+		YearPillar:     d.Year.String(),
+		MonthPillar:    d.Month.String(),
+		DayPillar:      d.Day.String(),
+	}
+	if d.HasTerm {
+		info.SolarTerm = &pb.SolarTermInfo{
+			Index:        int32(d.Term.Term),
+			Name:         d.Term.Term.String(),
+			LongitudeDeg: d.Term.Term.Longitude(),
+			IsJie:        d.Term.Term.IsJie(),
+			Time:         d.Term.Time.In(beijing).Format("2006-01-02 15:04:05"),
+			Unix:         d.Term.Time.Unix(),
+		}
+	}
+	return info
+}
@@ -0,0 +1,156 @@
+package bazi
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// CivilTimeRule describes which civil time a birth's clock reading was kept in.
+type CivilTimeRule struct {
+	// Name is a human-readable rule name, e.g. "北京时间", "北京夏令时", "陇蜀时区".
+	Name string
+	// Offset is the UTC offset in effect, including daylight saving time.
+	Offset time.Duration
+	// DST reports whether daylight saving time (夏令时) was in effect.
+	DST bool
+	// DSTSaving is how far clocks were set forward; zero without DST.
+	DSTSaving time.Duration
+}
+
+// StandardOffset is the zone's offset without daylight saving time.
+func (r CivilTimeRule) StandardOffset() time.Duration {
+	return r.Offset - r.DSTSaving
+}
+
+// OffsetString formats the offset as "+08:00".
+func (r CivilTimeRule) OffsetString() string {
+	return formatUTCOffset(r.Offset)
+}
+
+func formatUTCOffset(d time.Duration) string {
+	sign := "+"
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	m := int(d / time.Minute)
+	return fmt.Sprintf("%s%02d:%02d", sign, m/60, m%60)
+}
+
+// Historical civil time in mainland China:
+//   - before 1912: local mean time (地方平时), i.e. clocks followed the local sun
+//   - 1912 .. 1949-09-30: the five Republican time zones (长白/中原/陇蜀/新藏/昆仑)
+//   - from 1949-10-01: Beijing time (UTC+8) nationwide
+//   - 1986..1991: Beijing summer time (UTC+9) between mid-April and mid-September
+//
+// Wartime and 1940s summer time in individual cities is not modelled.
+var (
+	unifiedBeijingTimeStart = time.Date(1949, time.October, 1, 0, 0, 0, 0, time.UTC)
+	republicanZonesStart    = time.Date(1912, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+type republicanZone struct {
+	name   string
+	offset time.Duration
+}
+
+var (
+	zoneChangbai             = republicanZone{"长白时区", 8*time.Hour + 30*time.Minute}
+	zoneZhongyuan            = republicanZone{"中原时区", 8 * time.Hour}
+	zoneLongshu              = republicanZone{"陇蜀时区", 7 * time.Hour}
+	zoneXinzang              = republicanZone{"新藏时区", 6 * time.Hour}
+	zoneKunlun               = republicanZone{"昆仑时区", 5*time.Hour + 30*time.Minute}
+	republicanZoneByProvince = map[string]republicanZone{
+		"黑龙江": zoneChangbai,
+		"吉林":  zoneChangbai,
+
+		"陕西": zoneLongshu,
+		"甘肃": zoneLongshu,
+		"宁夏": zoneLongshu,
+		"青海": zoneLongshu,
+		"四川": zoneLongshu,
+		"重庆": zoneLongshu,
+		"贵州": zoneLongshu,
+		"云南": zoneLongshu,
+
+		"西藏": zoneXinzang,
+		"新疆": zoneXinzang,
+	}
+)
+
+// chinaDST reports the 1986-1991 summer time window of a year as wall-clock readings.
+// Clocks went forward at 02:00 on the start day and back from 02:00 to 01:00 on the
+// end day; both fall on Sundays from 1987 on.
+func chinaDST(year int) (start, end time.Time, ok bool) {
+	if year < 1986 || year > 1991 {
+		return time.Time{}, time.Time{}, false
+	}
+	if year == 1986 {
+		start = time.Date(1986, time.May, 4, 2, 0, 0, 0, time.UTC)
+	} else {
+		start = firstSundayOnOrAfter(year, time.April, 11).Add(2 * time.Hour)
+	}
+	end = firstSundayOnOrAfter(year, time.September, 11).Add(2 * time.Hour)
+	return start, end, true
+}
+
+func firstSundayOnOrAfter(year int, month time.Month, day int) time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return t.AddDate(0, 0, mod(int(time.Sunday-t.Weekday()), 7))
+}
+
+// ChinaCivilTime converts a clock reading entered for a birth in mainland China to
+// the real instant of birth, applying the historical civil time rule in force.
+//
+// province is the human-readable province name; lonDeg (when lonOK) is used for
+// local mean time before 1912 and to split 新疆 between the 新藏 and 昆仑 zones.
+// During the repeated hour when summer time ended, the summer-time reading is assumed.
+func ChinaCivilTime(solarDate, birthTime, province string, lonDeg float64, lonOK bool) (time.Time, CivilTimeRule, error) {
+	// Parse as a naive wall clock; the zone is decided below.
+	wall, err := time.ParseInLocation("2006-01-02 15:04", solarDate+" "+birthTime, time.UTC)
+	if err != nil {
+		return time.Time{}, CivilTimeRule{}, err
+	}
+	rule := chinaCivilTimeRule(wall, normalizeAdminName(province), lonDeg, lonOK)
+	return wall.Add(-rule.Offset).In(time.FixedZone("", int(rule.Offset/time.Second))), rule, nil
+}
+
+// ChinaCivilReading is the inverse of ChinaCivilTime: the clock reading of instant t
+// under the civil time rule then in force, as a time in a zone of that offset. In
+// the repeated hour when summer time ended it gives the standard-time reading, which
+// ChinaCivilTime reads back as summer time.
+func ChinaCivilReading(t time.Time, province string, lonDeg float64, lonOK bool) (time.Time, CivilTimeRule) {
+	province = normalizeAdminName(province)
+	rule := chinaCivilTimeRule(wallAsUTC(t.In(beijing)), province, lonDeg, lonOK)
+	// The rule is chosen by the reading itself; one more step settles readings
+	// near a change of rule.
+	rule = chinaCivilTimeRule(t.UTC().Add(rule.Offset), province, lonDeg, lonOK)
+	return t.In(time.FixedZone("", int(rule.Offset/time.Second))), rule
+}
+
+func chinaCivilTimeRule(wall time.Time, province string, lonDeg float64, lonOK bool) CivilTimeRule {
+	switch {
+	case !wall.Before(unifiedBeijingTimeStart):
+		if start, end, ok := chinaDST(wall.Year()); ok && !wall.Before(start) && wall.Before(end) {
+			return CivilTimeRule{Name: "北京夏令时", Offset: 9 * time.Hour, DST: true, DSTSaving: time.Hour}
+		}
+		return CivilTimeRule{Name: "北京时间", Offset: 8 * time.Hour}
+	case !wall.Before(republicanZonesStart):
+		z, ok := republicanZoneByProvince[province]
+		if !ok {
+			z = zoneZhongyuan
+		}
+		if province == "新疆" && lonOK && lonDeg < 85 {
+			z = zoneKunlun
+		}
+		return CivilTimeRule{Name: z.name, Offset: z.offset}
+	case lonOK:
+		// 1° of longitude = 4 minutes; round to the second.
+		sec := time.Duration(math.Round(lonDeg*240)) * time.Second
+		return CivilTimeRule{Name: "地方平时", Offset: sec}
+	default:
+		// No way to know the local meridian; assume the coastal 120°E.
+		return CivilTimeRule{Name: "地方平时(按120°E)", Offset: 8 * time.Hour}
+	}
+}
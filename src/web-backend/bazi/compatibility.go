@@ -0,0 +1,310 @@
+package bazi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "llyb-backend/proto"
+)
+
+// Two-person compatibility (合婚): the day masters, the spouse palaces (日支), the
+// zodiac years (年支), the relations between every pair of pillars across the two
+// charts, and how far each chart supplies the other's favourable elements.
+
+var zodiacNames = [12]string{"鼠", "牛", "虎", "兔", "龙", "蛇", "马", "羊", "猴", "鸡", "狗", "猪"}
+
+// Zodiac returns the animal (生肖) of a year branch.
+func (b Branch) Zodiac() string { return zodiacNames[mod(int(b), 12)] }
+
+// CompatibilityAspect is one scored finding.
+type CompatibilityAspect struct {
+	Category string // "day_master" | "spouse_palace" | "zodiac" | "elements"
+	Title    string
+	Detail   string
+	Score    int
+}
+
+// CrossInteraction is a relation between a pillar of the first chart and one of the
+// second.
+type CrossInteraction struct {
+	Interaction
+	First, Second Position
+}
+
+// CompatibilityResult is the result of AnalyzeCompatibility.
+type CompatibilityResult struct {
+	// Elements[i] is set only when HasElements[i]: the element balance takes all four
+	// pillars, so it is left out when the birth time leaves any undetermined.
+	Elements     [2]ElementAnalysis
+	HasElements  [2]bool
+	Aspects      []CompatibilityAspect
+	Interactions []CrossInteraction
+	// Score is 0..100, starting from compatibilityBase; Level labels it.
+	Score int
+	Level string
+}
+
+const compatibilityBase = 60
+
+// Scores of branch relations between the spouse palaces and between the years. A
+// combination draws the two together, a clash pulls them apart.
+var (
+	spousePalaceScores = map[InteractionKind]int{SixCombination: 12, HalfHarmony: 6, Clash: -12, Punishment: -6, Harm: -8, Destruction: -4}
+	zodiacScores       = map[InteractionKind]int{SixCombination: 8, HalfHarmony: 5, Clash: -8, Punishment: -4, Harm: -5, Destruction: -2}
+)
+
+var compatibilityLevels = []struct {
+	min   int
+	label string
+}{{80, "上等婚配"}, {65, "中上婚配"}, {50, "中等婚配"}, {0, "需多磨合"}}
+
+// AnalyzeCompatibility compares two charts. known[i] marks the pillars (year, month,
+// day, hour) of chart i its birth time determines: the others take no part in the
+// relations, and the aspects that need them are listed unscored.
+func AnalyzeCompatibility(first, second FourPillars, known [2][4]bool) CompatibilityResult {
+	var c CompatibilityResult
+	for i, p := range [2]FourPillars{first, second} {
+		if known[i] == [4]bool{true, true, true, true} {
+			c.Elements[i], c.HasElements[i] = AnalyzeElements(p), true
+		}
+	}
+	c.Score = compatibilityBase
+
+	add := func(a CompatibilityAspect) {
+		c.Aspects = append(c.Aspects, a)
+		c.Score += a.Score
+	}
+	unscored := func(category, title string) {
+		add(CompatibilityAspect{Category: category, Title: title, Detail: "出生时间不确定，所需的柱随出生时刻而定，无法判断"})
+	}
+	dayKnown := known[0][2] && known[1][2]
+
+	// Day masters: the stems of the two persons themselves.
+	if dayKnown {
+		add(dayMasterAspect(first.Day.Stem, second.Day.Stem))
+	} else {
+		unscored("day_master", "日主关系未计分")
+	}
+
+	// Relations between every pair of determined pillars across the charts.
+	var placed [2][]PlacedPillar
+	for i, p := range [2]FourPillars{first, second} {
+		for j, pp := range p.Placed() {
+			if known[i][j] {
+				placed[i] = append(placed[i], pp)
+			}
+		}
+	}
+	for _, a := range placed[0] {
+		for _, b := range placed[1] {
+			for _, in := range DetectInteractions([]PlacedPillar{a, b}) {
+				c.Interactions = append(c.Interactions, CrossInteraction{Interaction: in, First: a.Position, Second: b.Position})
+			}
+		}
+	}
+
+	// Spouse palaces and zodiac years, scored from the relations found above.
+	if dayKnown {
+		add(branchAspect("spouse_palace", "日支（夫妻宫）", first.Day.Branch, second.Day.Branch,
+			c.between(DayPos, DayPos), spousePalaceScores))
+	} else {
+		unscored("spouse_palace", "日支（夫妻宫）未计分")
+	}
+	if known[0][0] && known[1][0] {
+		add(branchAspect("zodiac", "生肖", first.Year.Branch, second.Year.Branch,
+			c.between(YearPos, YearPos), zodiacScores))
+	} else {
+		unscored("zodiac", "生肖未计分")
+	}
+
+	// Element complementarity: how much of each chart is made of the elements the
+	// other one needs. It takes both element balances.
+	if c.HasElements[0] && c.HasElements[1] {
+		for i := range c.Elements {
+			add(complementAspect(i, c.Elements[i], c.Elements[1-i]))
+		}
+	} else {
+		unscored("elements", "五行互补未计分")
+	}
+
+	c.Score = max(0, min(100, c.Score))
+	for _, l := range compatibilityLevels {
+		if c.Score >= l.min {
+			c.Level = l.label
+			break
+		}
+	}
+	return c
+}
+
+// between returns the cross relations between the first chart's pillar at a and the
+// second chart's pillar at b.
+func (c CompatibilityResult) between(a, b Position) []Interaction {
+	var out []Interaction
+	for _, ci := range c.Interactions {
+		if ci.First == a && ci.Second == b {
+			out = append(out, ci.Interaction)
+		}
+	}
+	return out
+}
+
+func dayMasterAspect(a, b Stem) CompatibilityAspect {
+	ea, eb := a.Element(), b.Element()
+	godAB, godBA := TenGodOf(a, b), TenGodOf(b, a)
+	gods := fmt.Sprintf("对方日主为己方之%s，己方日主为对方之%s", godAB, godBA)
+	asp := CompatibilityAspect{Category: "day_master"}
+	switch {
+	case mod(int(a)-int(b), 10) == 5:
+		lo := min(a, b)
+		asp.Title = fmt.Sprintf("日干%s%s相合", lo, lo+5)
+		asp.Detail = "天干五合，彼此吸引，情投意合；" + gods
+		asp.Score = 15
+	case ea.Generates() == eb || eb.Generates() == ea:
+		asp.Title = fmt.Sprintf("日干%s%s相生", a, b)
+		asp.Detail = "五行相生，一方扶持另一方；" + gods
+		asp.Score = 8
+	case ea == eb:
+		asp.Title = fmt.Sprintf("日干%s%s比和", a, b)
+		asp.Detail = "五行相同，志趣相投但易各执己见；" + gods
+		asp.Score = 3
+	case (godAB == DirectWealth || godAB == DirectOfficer) && (godBA == DirectWealth || godBA == DirectOfficer):
+		// 正财/正官: the controlling relation of opposite polarity, the classic spouse stars.
+		asp.Title = fmt.Sprintf("日干%s%s阴阳相克", a, b)
+		asp.Detail = "克中有情，互为财官；" + gods
+		asp.Score = 5
+	default:
+		asp.Title = fmt.Sprintf("日干%s%s相克", a, b)
+		asp.Detail = "五行相克且阴阳相同，相处易生摩擦；" + gods
+		asp.Score = -6
+	}
+	return asp
+}
+
+func branchAspect(category, label string, a, b Branch, ins []Interaction, scores map[InteractionKind]int) CompatibilityAspect {
+	asp := CompatibilityAspect{Category: category}
+	var names []string
+	for _, in := range ins {
+		if s, ok := scores[in.Kind]; ok {
+			asp.Score += s
+			names = append(names, in.Name)
+		}
+	}
+	subject := label + a.String() + b.String()
+	if category == "zodiac" {
+		subject = label + a.Zodiac() + b.Zodiac()
+	}
+	switch {
+	case len(names) == 0:
+		asp.Title = subject + "无合冲"
+		asp.Detail = "两者之间无明显的合、冲、刑、害、破"
+	case asp.Score > 0:
+		asp.Title = subject + "相合"
+		asp.Detail = strings.Join(names, "、")
+	default:
+		asp.Title = subject + "相冲克"
+		asp.Detail = strings.Join(names, "、")
+	}
+	return asp
+}
+
+// complementAspect scores how much of the partner's chart is made of the elements
+// favourable to chart i.
+func complementAspect(i int, self, partner ElementAnalysis) CompatibilityAspect {
+	var share float64
+	for _, e := range self.Favourable {
+		share += partner.Percent[e]
+	}
+	who := [2]string{"第一人", "第二人"}[i]
+	asp := CompatibilityAspect{
+		Category: "elements",
+		Detail: fmt.Sprintf("%s喜用%s，对方命局中此类五行占%.0f%%", who,
+			strings.Join(elementList(self.Favourable), ""), share),
+	}
+	switch {
+	case share >= 45:
+		asp.Title, asp.Score = who+"得对方五行补益", 8
+	case share >= 30:
+		asp.Title, asp.Score = who+"与对方五行较平", 2
+	default:
+		asp.Title, asp.Score = who+"难得对方五行补益", -4
+	}
+	return asp
+}
+
+// Compatibility is the backend handler for "/bazi/compatibility": both charts and a
+// scored compatibility analysis.
+func Compatibility(ctx context.Context, req *pb.CompatibilityRequest) (*pb.CompatibilityResponse, error) {
+	var births [2]*birth
+	for i, r := range []*pb.ReasoningRequest{req.GetFirst(), req.GetSecond()} {
+		b, err := resolveBirth(ctx, r)
+		if err != nil {
+			return &pb.CompatibilityResponse{
+				Code:    1002,
+				Message: fmt.Sprintf("%s：%s", [2]string{"第一人", "第二人"}[i], err.Error()),
+			}, nil
+		}
+		births[i] = b
+	}
+
+	var known [2][4]bool
+	for i, b := range births {
+		known[i] = [4]bool{true, true, true, true}
+		if b.timeMode != "exact" {
+			_, known[i] = commonPillars(b.candidates)
+		}
+	}
+	c := AnalyzeCompatibility(births[0].pillars, births[1].pillars, known)
+
+	resp := &pb.CompatibilityResponse{
+		Code:    0,
+		Message: "ok",
+		Score:   int32(c.Score),
+		Level:   c.Level,
+	}
+	for i, b := range births {
+		summary := &pb.ChartSummary{
+			Bazi:        baziText(b.pillars.Placed(), known[i]),
+			DayBoundary: b.dayBoundary.String(),
+			Approximate: known[i] != [4]bool{true, true, true, true},
+		}
+		if known[i][2] {
+			dm := b.pillars.Day.Stem
+			summary.DayMaster, summary.DayMasterElement = dm.String(), dm.Element().String()
+		}
+		if known[i][0] {
+			summary.Zodiac = b.pillars.Year.Branch.Zodiac()
+		}
+		if c.HasElements[i] {
+			summary.Strength = c.Elements[i].Category.String()
+			summary.Favourable = elementList(c.Elements[i].Favourable)
+		}
+		if i == 0 {
+			resp.First = summary
+		} else {
+			resp.Second = summary
+		}
+	}
+	for _, a := range c.Aspects {
+		resp.Aspects = append(resp.Aspects, &pb.CompatibilityAspect{
+			Category: a.Category,
+			Title:    a.Title,
+			Detail:   a.Detail,
+			Score:    int32(a.Score),
+		})
+	}
+	for _, ci := range c.Interactions {
+		info := &pb.CrossInteractionInfo{
+			Kind:           ci.Kind.String(),
+			Name:           ci.Name,
+			FirstPosition:  ci.First.String(),
+			SecondPosition: ci.Second.String(),
+		}
+		if ci.HasElement {
+			info.Element = ci.Element.String()
+		}
+		resp.Interactions = append(resp.Interactions, info)
+	}
+	return resp, nil
+}
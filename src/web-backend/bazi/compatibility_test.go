@@ -0,0 +1,40 @@
+package bazi
+
+import "testing"
+
+// An undetermined day pillar is neither scored nor related to the other chart.
+func TestAnalyzeCompatibilityUnknownDay(t *testing.T) {
+	first := FourPillars{Year: PillarFromIndex(6), Month: PillarFromIndex(16), Day: PillarFromIndex(2), Hour: PillarFromIndex(28)}
+	second := FourPillars{Year: PillarFromIndex(8), Month: PillarFromIndex(39), Day: PillarFromIndex(32), Hour: PillarFromIndex(8)}
+	all := [4]bool{true, true, true, true}
+
+	c := AnalyzeCompatibility(first, second, [2][4]bool{all, {true, true, false, false}})
+	if c.HasElements[1] {
+		t.Error("elements analysed for a chart with undetermined pillars")
+	}
+	for _, a := range c.Aspects {
+		switch a.Category {
+		case "day_master", "spouse_palace", "elements":
+			if a.Score != 0 {
+				t.Errorf("%s scored %d, want unscored", a.Title, a.Score)
+			}
+		}
+	}
+	for _, ci := range c.Interactions {
+		if ci.Second == DayPos || ci.Second == HourPos {
+			t.Errorf("relation %s with an undetermined %s pillar", ci.Name, ci.Second)
+		}
+	}
+
+	// With both charts determined the day pillars are scored: 丙寅 and 丙申 clash.
+	c = AnalyzeCompatibility(first, second, [2][4]bool{all, all})
+	var spouse int
+	for _, a := range c.Aspects {
+		if a.Category == "spouse_palace" {
+			spouse = a.Score
+		}
+	}
+	if spouse >= 0 {
+		t.Errorf("spouse palace 寅申 scored %d, want a clash", spouse)
+	}
+}
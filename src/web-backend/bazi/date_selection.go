@@ -0,0 +1,302 @@
+package bazi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// Date selection (择日): rank the days of a range for an activity by the almanac and
+// by how each day's pillar meets the participants' charts. Days are evaluated
+// concurrently by a bounded pool of workers and the search stops when ctx is done.
+
+const (
+	maxSelectionDays         = 366
+	maxSelectionParticipants = 6
+	defaultSelectionLimit    = 10
+	maxSelectionLimit        = 60
+)
+
+// activityAliases maps the English activity names to almanac activities; a day suits
+// the activity when any of them is 宜.
+var activityAliases = map[string][]string{
+	"wedding": {"嫁娶"},
+	"moving":  {"移徙", "入宅"},
+	"opening": {"开市", "交易"},
+}
+
+// Participant is a person the chosen day must suit.
+type Participant struct {
+	Label   string // e.g. "第1人"
+	Pillars FourPillars
+	// Known marks the pillars (year, month, day, hour) the birth time determines; the
+	// others are left out of the scoring.
+	Known [4]bool
+}
+
+// DayReason is one scored finding about a candidate day.
+type DayReason struct {
+	Text  string
+	Score int
+}
+
+// DayCandidate is a day with its score; Suitable means the activity is 宜 and no
+// participant's year or day branch is clashed.
+type DayCandidate struct {
+	Almanac  Almanac
+	Score    int
+	Suitable bool
+	Reasons  []DayReason
+}
+
+const selectionBase = 50
+
+// Scores of the day branch's relations with a participant's year branch (生肖) and
+// day branch (日支).
+var (
+	selectionYearScores = map[InteractionKind]int{SixCombination: 8, HalfHarmony: 4, Clash: -20, Punishment: -6, Harm: -6, Destruction: -3}
+	selectionDayScores  = map[InteractionKind]int{SixCombination: 5, HalfHarmony: 3, Clash: -15, Punishment: -4, Harm: -4, Destruction: -2}
+)
+
+// SelectDates scores every day from start to end (inclusive, Beijing dates) for the
+// activities and returns them best first.
+func SelectDates(ctx context.Context, activities []string, people []Participant, start, end time.Time) ([]DayCandidate, error) {
+	var days []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		days = append(days, d)
+	}
+	// A year either side: the days around New Year take their pillars from the
+	// previous year's 冬至/小寒 and 立春.
+	terms := solarTermsBetween(start.Year()-1, end.Year()+1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	out := make([]DayCandidate, len(days))
+	jobs := make(chan int)
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		errOnce.Do(func() { firstErr = err; cancel() })
+	}
+	for w := 0; w < min(runtime.GOMAXPROCS(0), 8, len(days)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				a, err := almanacOf(days[i], terms)
+				if err != nil {
+					fail(err)
+					continue
+				}
+				out[i] = scoreDay(a, activities, people)
+			}
+		}()
+	}
+feed:
+	for i := range days {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Suitable != out[j].Suitable {
+			return out[i].Suitable
+		}
+		return out[i].Score > out[j].Score
+	})
+	return out, nil
+}
+
+func scoreDay(a Almanac, activities []string, people []Participant) DayCandidate {
+	c := DayCandidate{Almanac: a, Score: selectionBase, Suitable: true}
+	add := func(score int, format string, args ...any) {
+		c.Reasons = append(c.Reasons, DayReason{Text: fmt.Sprintf(format, args...), Score: score})
+		c.Score += score
+	}
+
+	var yi, ji []string
+	for _, act := range activities {
+		if containsString(a.Yi, act) {
+			yi = append(yi, act)
+		}
+		if containsString(a.Ji, act) {
+			ji = append(ji, act)
+		}
+	}
+	switch {
+	case len(ji) > 0:
+		add(-40, "%s日忌%s", a.Officer, strings.Join(ji, "、"))
+		c.Suitable = false
+	case len(yi) > 0:
+		add(20, "%s日宜%s", a.Officer, strings.Join(yi, "、"))
+	default:
+		add(0, "%s日宜忌未列%s", a.Officer, strings.Join(activities, "、"))
+		c.Suitable = false
+	}
+	if a.Mansion.Auspicious() {
+		add(5, "值日星宿%s为吉", a.Mansion.FullName())
+	} else {
+		add(-5, "值日星宿%s为凶", a.Mansion.FullName())
+	}
+	if a.YearClash {
+		add(-10, "日冲太岁（岁破）")
+	}
+
+	day := PlacedPillar{Position: DayPos, Pillar: a.Day}
+	for _, p := range people {
+		for _, t := range []struct {
+			known  bool
+			what   string
+			pillar Pillar
+			scores map[InteractionKind]int
+		}{
+			{p.Known[0], "生肖" + p.Pillars.Year.Branch.Zodiac(), p.Pillars.Year, selectionYearScores},
+			{p.Known[2], "日支" + p.Pillars.Day.Branch.String(), p.Pillars.Day, selectionDayScores},
+		} {
+			if !t.known {
+				continue
+			}
+			for _, in := range DetectInteractions([]PlacedPillar{day, {Position: YearPos, Pillar: t.pillar}}) {
+				s, ok := t.scores[in.Kind]
+				if !ok {
+					continue
+				}
+				add(s, "%s%s：%s", p.Label, t.what, in.Name)
+				if in.Kind == Clash {
+					c.Suitable = false
+				}
+			}
+		}
+		if !p.Known[2] {
+			continue
+		}
+		dm := p.Pillars.Day.Stem
+		switch {
+		case mod(int(a.Day.Stem)-int(dm), 10) == 5:
+			add(3, "日干%s与%s日主%s相合", a.Day.Stem, p.Label, dm)
+		case TenGodOf(dm, a.Day.Stem) == SevenKillings:
+			add(-5, "日干%s为%s日主之七杀", a.Day.Stem, p.Label)
+		}
+	}
+	return c
+}
+
+// knownActivity reports whether act appears in the almanac rule table.
+func knownActivity(act string) (bool, error) {
+	rules, err := loadAlmanacRules()
+	if err != nil {
+		return false, err
+	}
+	for _, r := range rules {
+		if containsString(r.yi, act) || containsString(r.ji, act) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// DateSelection is the backend handler for "/almanac/date-selection".
+func DateSelection(ctx context.Context, req *pb.DateSelectionRequest) (*pb.DateSelectionResponse, error) {
+	act := strings.TrimSpace(req.GetActivity())
+	activities, ok := activityAliases[strings.ToLower(act)]
+	if !ok {
+		known, err := knownActivity(act)
+		if err != nil {
+			return nil, err
+		}
+		if !known {
+			return &pb.DateSelectionResponse{Code: 1002, Message: "不支持的事项，可填 wedding、moving、opening 或黄历中的事项（如“嫁娶”）"}, nil
+		}
+		activities = []string{act}
+	}
+
+	start, err1 := time.ParseInLocation("2006-01-02", req.GetStartDate(), beijing)
+	end, err2 := time.ParseInLocation("2006-01-02", req.GetEndDate(), beijing)
+	switch {
+	case err1 != nil || err2 != nil || end.Before(start):
+		return &pb.DateSelectionResponse{Code: 1002, Message: "日期范围不合法，应为 YYYY-MM-DD 且起始不晚于结束"}, nil
+	case start.Year() < calendarMinYear || end.Year() > calendarMaxYear:
+		return &pb.DateSelectionResponse{
+			Code:    1002,
+			Message: fmt.Sprintf("日期不合法，支持 %d 至 %d 年", calendarMinYear, calendarMaxYear),
+		}, nil
+	case end.Sub(start) > maxSelectionDays*24*time.Hour:
+		return &pb.DateSelectionResponse{Code: 1002, Message: fmt.Sprintf("起止日期相隔不能超过 %d 天", maxSelectionDays)}, nil
+	}
+
+	if n := len(req.GetParticipants()); n == 0 || n > maxSelectionParticipants {
+		return &pb.DateSelectionResponse{Code: 1002, Message: fmt.Sprintf("请填写 1 至 %d 位参与者的出生信息", maxSelectionParticipants)}, nil
+	}
+	var people []Participant
+	for i, r := range req.GetParticipants() {
+		label := fmt.Sprintf("第%d人", i+1)
+		b, err := resolveBirth(ctx, r)
+		if err != nil {
+			return &pb.DateSelectionResponse{Code: 1002, Message: label + "：" + err.Error()}, nil
+		}
+		known := [4]bool{true, true, true, true}
+		if b.timeMode != "exact" {
+			_, known = commonPillars(b.candidates)
+		}
+		people = append(people, Participant{Label: label, Pillars: b.pillars, Known: known})
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultSelectionLimit
+	}
+	if limit > maxSelectionLimit {
+		limit = maxSelectionLimit
+	}
+
+	ranked, err := SelectDates(ctx, activities, people, start, end)
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		// The caller gave up or ran out of time; that is not a server fault.
+		return &pb.DateSelectionResponse{Code: 1004, Message: "请求已取消或超时"}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.DateSelectionResponse{
+		Code:       0,
+		Message:    "ok",
+		Activities: activities,
+		Evaluated:  int32(len(ranked)),
+	}
+	for _, c := range ranked[:min(limit, len(ranked))] {
+		info := &pb.DateCandidate{
+			Date:      c.Almanac.Date.Format("2006-01-02"),
+			DayPillar: c.Almanac.Day.String(),
+			Score:     int32(c.Score),
+			Suitable:  c.Suitable,
+			Officer:   c.Almanac.Officer.String(),
+			Mansion:   c.Almanac.Mansion.FullName(),
+		}
+		for _, r := range c.Reasons {
+			info.Reasons = append(info.Reasons, &pb.DateReason{Text: r.Text, Score: int32(r.Score)})
+		}
+		resp.Days = append(resp.Days, info)
+	}
+	return resp, nil
+}
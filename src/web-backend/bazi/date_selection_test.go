@@ -0,0 +1,124 @@
+package bazi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	pb "llyb-backend/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// A 366-day range starting on New Year's Eve crosses two new years; every day must
+// match the almanac computed on its own.
+func TestSelectDatesAcrossNewYears(t *testing.T) {
+	start := time.Date(2024, time.December, 31, 0, 0, 0, 0, beijing)
+	end := time.Date(2026, time.January, 1, 0, 0, 0, 0, beijing)
+	days, err := SelectDates(context.Background(), []string{"嫁娶"}, nil, start, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 367 {
+		t.Fatalf("got %d days, want 367", len(days))
+	}
+	for _, c := range days {
+		got := c.Almanac
+		want, err := AlmanacOf(got.Date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Year != want.Year || got.Month != want.Month || got.Day != want.Day || got.YearClash != want.YearClash {
+			t.Errorf("%s: got %s %s %s (岁破 %v), want %s %s %s (岁破 %v)", got.Date.Format("2006-01-02"),
+				got.Year, got.Month, got.Day, got.YearClash, want.Year, want.Month, want.Day, want.YearClash)
+		}
+	}
+}
+
+// A participant's undetermined pillars take no part in the clash checks.
+func TestScoreDaySkipsUnknownPillars(t *testing.T) {
+	a, err := AlmanacOf(time.Date(2025, time.March, 1, 0, 0, 0, 0, beijing))
+	if err != nil {
+		t.Fatal(err)
+	}
+	clash := PillarFromIndex(a.Day.Index() + 6) // the day's branch clashes this one's
+	p := Participant{Label: "第1人", Pillars: FourPillars{Year: a.Year, Month: a.Month, Day: clash, Hour: clash}}
+
+	p.Known = [4]bool{true, true, true, true}
+	if c := scoreDay(a, []string{"嫁娶"}, []Participant{p}); c.Suitable || !hasReason(c, "日支") {
+		t.Errorf("known day pillar: suitable=%v reasons=%v, want a 日支 clash", c.Suitable, c.Reasons)
+	}
+	p.Known = [4]bool{true, true, false, false}
+	if c := scoreDay(a, []string{"嫁娶"}, []Participant{p}); hasReason(c, "日支") || hasReason(c, "日主") {
+		t.Errorf("unknown day pillar: reasons=%v, want none about the day pillar", c.Reasons)
+	}
+}
+
+// A cancelled or expired search stops feeding days, returns ctx.Err() promptly and
+// leaves no workers behind.
+func TestSelectDatesCancelled(t *testing.T) {
+	start := time.Date(2025, time.January, 1, 0, 0, 0, 0, beijing)
+	end := start.AddDate(0, 0, maxSelectionDays)
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	for _, tc := range []struct {
+		ctx  context.Context
+		want error
+	}{
+		{cancelled, context.Canceled},
+		{expired, context.DeadlineExceeded},
+	} {
+		before := runtime.NumGoroutine()
+		began := time.Now()
+		days, err := SelectDates(tc.ctx, []string{"嫁娶"}, nil, start, end)
+		if !errors.Is(err, tc.want) || days != nil {
+			t.Errorf("got %d days, err %v; want none and %v", len(days), err, tc.want)
+		}
+		if d := time.Since(began); d > time.Second {
+			t.Errorf("%v: returned after %v", tc.want, d)
+		}
+		if after := runtime.NumGoroutine(); after > before {
+			t.Errorf("%v: %d goroutines before the search, %d after", tc.want, before, after)
+		}
+	}
+}
+
+// A cancelled request is answered as such, not as a server error.
+func TestDateSelectionCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := DateSelection(ctx, &pb.DateSelectionRequest{
+		Activity: "wedding",
+		Participants: []*pb.ReasoningRequest{{
+			Gender:    pb.Gender_GENDER_FEMALE,
+			SolarDate: "1995-06-15",
+			BirthTime: "08:30",
+			Province:  "北京市",
+			City:      "北京市",
+			Longitude: proto.Float64(116.4),
+		}},
+		StartDate: "2025-01-01",
+		EndDate:   "2025-12-31",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetCode() != 1004 {
+		t.Errorf("got code %d (%s), want 1004", resp.GetCode(), resp.GetMessage())
+	}
+}
+
+func hasReason(c DayCandidate, substr string) bool {
+	for _, r := range c.Reasons {
+		if strings.Contains(r.Text, substr) {
+			return true
+		}
+	}
+	return false
+}
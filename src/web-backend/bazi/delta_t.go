@@ -0,0 +1,81 @@
+package bazi
+
+import "time"
+
+// deltaTSeconds returns ΔT = TT - UT in seconds for a decimal year.
+//
+// Polynomial fits by Espenak & Meeus (NASA, "Five Millennium Canon of Solar Eclipses"),
+// from 500 on, which covers the solar term range; earlier years take the long-term
+// parabola. Beyond the observed range the values are extrapolations; the error grows
+// to about a minute around 2100, which is still well within a single solar term
+// search step.
+func deltaTSeconds(y float64) float64 {
+	switch {
+	case y < 500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 1600:
+		u := (y - 1000) / 100
+		return 1574.2 - 556.01*u + 71.23472*u*u + 0.319781*u*u*u - 0.8503463*u*u*u*u -
+			0.005050998*u*u*u*u*u + 0.0083572073*u*u*u*u*u*u
+	case y < 1700:
+		t := y - 1600
+		return 120 - 0.9808*t - 0.01532*t*t + t*t*t/7129
+	case y < 1800:
+		t := y - 1700
+		return 8.83 + 0.1603*t - 0.0059285*t*t + 0.00013336*t*t*t - t*t*t*t/1174000
+	case y < 1860:
+		t := y - 1800
+		return 13.72 - 0.332447*t + 0.0068612*t*t + 0.0041116*t*t*t - 0.00037436*t*t*t*t +
+			0.0000121272*t*t*t*t*t - 0.0000001699*t*t*t*t*t*t + 0.000000000875*t*t*t*t*t*t*t
+	case y < 1900:
+		t := y - 1860
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*t*t*t*t + t*t*t*t*t/233174
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// julianEphemerisDay returns the Julian Ephemeris Day (TT) of the given instant.
+func julianEphemerisDay(t time.Time) float64 {
+	jd := julianDay(t)
+	return jd + deltaTSeconds(decimalYear(jd))/86400.0
+}
+
+// decimalYear converts a Julian Day to an approximate decimal Gregorian year,
+// which is all ΔT needs.
+func decimalYear(jd float64) float64 {
+	return 2000.0 + (jd-2451544.5)/365.2425
+}
+
+// julianDayToTime converts a Julian Day (UT) to a UTC time.
+func julianDayToTime(jd float64) time.Time {
+	sec := (jd - 2440587.5) * 86400.0
+	whole := int64(sec)
+	if float64(whole) > sec {
+		whole--
+	}
+	return time.Unix(whole, int64((sec-float64(whole))*1e9)).UTC()
+}
@@ -0,0 +1,92 @@
+package bazi
+
+// Element is one of the five phases (五行), ordered so that each generates the next:
+// 0=木 1=火 2=土 3=金 4=水.
+type Element int
+
+const (
+	Wood Element = iota
+	Fire
+	Earth
+	Metal
+	Water
+)
+
+var elementNames = [5]string{"木", "火", "土", "金", "水"}
+
+func (e Element) String() string { return elementNames[mod(int(e), 5)] }
+
+// Generates returns the element e produces (生), e.g. 木生火.
+func (e Element) Generates() Element { return Element(mod(int(e)+1, 5)) }
+
+// Controls returns the element e restrains (克), e.g. 木克土.
+func (e Element) Controls() Element { return Element(mod(int(e)+2, 5)) }
+
+// Element of a stem: 甲乙木 丙丁火 戊己土 庚辛金 壬癸水.
+func (s Stem) Element() Element { return Element(mod(int(s), 10) / 2) }
+
+// IsYang reports whether the stem is yang (甲丙戊庚壬).
+func (s Stem) IsYang() bool { return mod(int(s), 10)%2 == 0 }
+
+var branchElements = [12]Element{Water, Earth, Wood, Wood, Earth, Fire, Fire, Earth, Metal, Metal, Earth, Water}
+
+// Element of a branch by its main qi: 寅卯木 巳午火 申酉金 亥子水 辰戌丑未土.
+func (b Branch) Element() Element { return branchElements[mod(int(b), 12)] }
+
+// IsYang reports whether the branch is yang (子寅辰午申戌).
+func (b Branch) IsYang() bool { return mod(int(b), 12)%2 == 0 }
+
+// branchHiddenStems lists the stems stored in each branch (藏干) as main qi (本气),
+// middle qi (中气) and residual qi (余气), in that order. The middle qi of 辰戌丑未 is
+// the element they store (库); the residual qi is what remains of the previous season.
+var branchHiddenStems = [12][]Stem{
+	{9},       // 子: 癸
+	{5, 7, 9}, // 丑: 己 辛 癸
+	{0, 2, 4}, // 寅: 甲 丙 戊
+	{1},       // 卯: 乙
+	{4, 9, 1}, // 辰: 戊 癸 乙
+	{2, 6, 4}, // 巳: 丙 庚 戊
+	{3, 5},    // 午: 丁 己
+	{5, 1, 3}, // 未: 己 乙 丁
+	{6, 8, 4}, // 申: 庚 壬 戊
+	{7},       // 酉: 辛
+	{4, 3, 7}, // 戌: 戊 丁 辛
+	{8, 0},    // 亥: 壬 甲
+}
+
+// hiddenStemWeights splits a branch's weight among its hidden stems, by their count.
+var hiddenStemWeights = [4][]float64{
+	1: {1},
+	2: {0.7, 0.3},
+	3: {0.6, 0.3, 0.1},
+}
+
+// QiKind is the role of a hidden stem within its branch.
+type QiKind int
+
+const (
+	MainQi     QiKind = iota // 本气
+	MiddleQi                 // 中气
+	ResidualQi               // 余气
+)
+
+var qiKindNames = [3]string{"本气", "中气", "余气"}
+
+func (k QiKind) String() string { return qiKindNames[k] }
+
+// HiddenStem is a stem stored in a branch (藏干).
+type HiddenStem struct {
+	Stem   Stem
+	Qi     QiKind
+	Weight float64 // share of the branch, summing to 1 over the branch
+}
+
+// HiddenStems returns the hidden stems of b, main qi first.
+func HiddenStems(b Branch) []HiddenStem {
+	stems := branchHiddenStems[mod(int(b), 12)]
+	out := make([]HiddenStem, len(stems))
+	for i, s := range stems {
+		out[i] = HiddenStem{Stem: s, Qi: QiKind(i), Weight: hiddenStemWeights[len(stems)][i]}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package bazi
+
+import (
+	"math"
+	"strings"
+)
+
+// Stem is one of the ten heavenly stems (天干), 0=甲 ... 9=癸.
+type Stem int
+
+// Branch is one of the twelve earthly branches (地支), 0=子 ... 11=亥.
+type Branch int
+
+var stemNames = [10]string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+
+var branchNames = [12]string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+
+func (s Stem) String() string { return stemNames[mod(int(s), 10)] }
+
+func (b Branch) String() string { return branchNames[mod(int(b), 12)] }
+
+// Pillar is a stem-branch pair (干支), e.g. 甲子.
+type Pillar struct {
+	Stem   Stem
+	Branch Branch
+}
+
+// PillarFromIndex returns the i-th pillar of the sexagenary cycle (甲子=0, 乙丑=1, ...).
+// Out-of-range values wrap around.
+func PillarFromIndex(i int) Pillar {
+	i = mod(i, 60)
+	return Pillar{Stem: Stem(i % 10), Branch: Branch(i % 12)}
+}
+
+// Index returns the position of p in the sexagenary cycle (0..59).
+func (p Pillar) Index() int {
+	// Solve i ≡ stem (mod 10), i ≡ branch (mod 12); only defined when parities match.
+	return mod(6*int(p.Stem)-5*int(p.Branch), 60)
+}
+
+func (p Pillar) String() string { return p.Stem.String() + p.Branch.String() }
+
+// ParsePillar parses a pillar written as two characters, e.g. "甲子". Pairs outside the
+// sexagenary cycle (stem and branch of different polarity, e.g. "甲丑") are rejected.
+func ParsePillar(s string) (Pillar, bool) {
+	r := []rune(strings.TrimSpace(s))
+	if len(r) != 2 {
+		return Pillar{}, false
+	}
+	st, br := -1, -1
+	for i, n := range stemNames {
+		if n == string(r[0]) {
+			st = i
+		}
+	}
+	for i, n := range branchNames {
+		if n == string(r[1]) {
+			br = i
+		}
+	}
+	if st < 0 || br < 0 || st%2 != br%2 {
+		return Pillar{}, false
+	}
+	return Pillar{Stem: Stem(st), Branch: Branch(br)}, true
+}
+
+// mod is the always-non-negative remainder.
+func mod(a, n int) int {
+	r := a % n
+	if r < 0 {
+		r += n
+	}
+	return r
+}
+
+// modf is the always-non-negative floating-point remainder.
+func modf(a, n float64) float64 {
+	r := math.Mod(a, n)
+	if r < 0 {
+		r += n
+	}
+	return r
+}
@@ -0,0 +1,136 @@
+package bazi
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Offline Chinese administrative gazetteer (province/city/district coordinates).
+//
+// It is the primary longitude source so that true solar time works without AMap
+// credentials or network access. See data/gazetteer_cn.csv for the format.
+
+//go:embed data/gazetteer_cn.csv
+var gazetteerCSV string
+
+type gazetteerEntry struct {
+	province string // normalized names, see normalizeAdminName
+	city     string
+	district string
+	lon      float64
+	lat      float64
+}
+
+type gazetteerIndex struct {
+	entries []gazetteerEntry
+	byCity  map[string][]int // normalized "province|city" -> city-level entries
+}
+
+var loadGazetteer = sync.OnceValues(func() (*gazetteerIndex, error) {
+	r := csv.NewReader(strings.NewReader(gazetteerCSV))
+	r.Comment = '#'
+	r.FieldsPerRecord = 5
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("gazetteer: %w", err)
+	}
+	idx := &gazetteerIndex{byCity: make(map[string][]int)}
+	for _, rec := range records {
+		lon, err1 := strconv.ParseFloat(rec[3], 64)
+		lat, err2 := strconv.ParseFloat(rec[4], 64)
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("gazetteer: invalid coordinates in %v", rec)
+		}
+		e := gazetteerEntry{
+			province: normalizeAdminName(rec[0]),
+			city:     normalizeAdminName(rec[1]),
+			district: strings.TrimSpace(rec[2]),
+			lon:      lon,
+			lat:      lat,
+		}
+		idx.entries = append(idx.entries, e)
+		if e.district == "" {
+			key := e.province + "|" + e.city
+			idx.byCity[key] = append(idx.byCity[key], len(idx.entries)-1)
+		}
+	}
+	return idx, nil
+})
+
+// cityPlaceholders are the pseudo "city" levels of the national division codes,
+// used for municipalities and county-level cities directly under a province.
+var cityPlaceholders = map[string]bool{
+	"市辖区":         true,
+	"县":           true,
+	"省直辖县级行政区划":   true,
+	"自治区直辖县级行政区划": true,
+}
+
+// lookupCity finds a city-level entry. province and city are normalized names;
+// province may be empty. Short forms such as "延边" for "延边朝鲜族自治州" match by prefix
+// as long as the match is unique.
+func (g *gazetteerIndex) lookupCity(province, city string) (gazetteerEntry, bool) {
+	if cityPlaceholders[city] || city == "" {
+		// Municipalities: the province is the city.
+		city = province
+	}
+	if city == "" {
+		return gazetteerEntry{}, false
+	}
+	if ids := g.byCity[province+"|"+city]; len(ids) == 1 {
+		return g.entries[ids[0]], true
+	}
+
+	var found []gazetteerEntry
+	for _, e := range g.entries {
+		if e.district != "" || (province != "" && e.province != province) {
+			continue
+		}
+		if e.city == city || (len([]rune(city)) >= 2 && strings.HasPrefix(e.city, city)) {
+			found = append(found, e)
+		}
+	}
+	if len(found) == 1 {
+		return found[0], true
+	}
+	return gazetteerEntry{}, false
+}
+
+// lookupDistrict finds a district/county-level entry under the given city. district is
+// the raw name ("西湖区"); a unique prefix of at least two characters also matches.
+// County-level cities directly under a province (city is a placeholder such as
+// 省直辖县级行政区划) are listed as cities and found through lookupCity.
+func (g *gazetteerIndex) lookupDistrict(province, city, district string) (gazetteerEntry, bool) {
+	if district == "" {
+		return gazetteerEntry{}, false
+	}
+	if cityPlaceholders[city] || city == "" {
+		if e, ok := g.lookupCity(province, normalizeAdminName(district)); ok {
+			return e, true
+		}
+	}
+	c, ok := g.lookupCity(province, city)
+	if !ok {
+		return gazetteerEntry{}, false
+	}
+	var found []gazetteerEntry
+	for _, e := range g.entries {
+		if e.district == "" || e.province != c.province || e.city != c.city {
+			continue
+		}
+		if e.district == district {
+			return e, true
+		}
+		if len([]rune(district)) >= 2 && strings.HasPrefix(e.district, district) {
+			found = append(found, e)
+		}
+	}
+	if len(found) == 1 {
+		return found[0], true
+	}
+	return gazetteerEntry{}, false
+}
@@ -0,0 +1,69 @@
+package bazi
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+// testdata/pca.json holds the province -> city -> county names of the frontend
+// region picker, in the layout of china-division's dist/pca.json (the names of the
+// pca-code.json the picker loads).
+func loadRegionPicker(t *testing.T) map[string]map[string][]string {
+	t.Helper()
+	raw, err := os.ReadFile("testdata/pca.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pca map[string]map[string][]string
+	if err := json.Unmarshal(raw, &pca); err != nil {
+		t.Fatal(err)
+	}
+	return pca
+}
+
+// Every place the region picker offers resolves offline: each province/city pair to
+// a city seat, and each county to its own seat.
+func TestGazetteerCoversRegionPicker(t *testing.T) {
+	g, err := loadGazetteer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for province, cities := range loadRegionPicker(t) {
+		p := normalizeAdminName(province)
+		for city, counties := range cities {
+			c := normalizeAdminName(city)
+			// County-level cities directly under a province have no common seat.
+			if city != "省直辖县级行政区划" && city != "自治区直辖县级行政区划" {
+				if _, ok := g.lookupCity(p, c); !ok {
+					t.Errorf("%s %s: no city entry", province, city)
+				}
+			}
+			for _, county := range counties {
+				if _, ok := g.lookupDistrict(p, c, county); !ok {
+					t.Errorf("%s %s %s: no county entry", province, city, county)
+				}
+			}
+		}
+	}
+}
+
+// A county resolves to its own seat, not the prefecture's: 巫山县 is about 3° east
+// of central 重庆, some 13 minutes of solar time.
+func TestGazetteerCountyLongitude(t *testing.T) {
+	g, err := loadGazetteer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	city, ok := g.lookupCity("重庆", "县")
+	if !ok {
+		t.Fatal("no entry for 重庆")
+	}
+	county, ok := g.lookupDistrict("重庆", "县", "巫山县")
+	if !ok {
+		t.Fatal("no entry for 巫山县")
+	}
+	if d := county.lon - city.lon; d < 2.5 {
+		t.Errorf("巫山县 at %.2f°E, only %.2f° east of 重庆 %.2f°E", county.lon, d, city.lon)
+	}
+}
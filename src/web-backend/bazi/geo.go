@@ -2,55 +2,88 @@ package bazi
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net"
-	"net/http"
-	"net/url"
-	"os"
-	"strconv"
 	"strings"
 	"sync"
-	"time"
 )
 
-// ResolveCityLongitude resolves a city's longitude (degrees East) based on the provided
-// human-readable province/city names.
+// ResolveCityLongitude resolves a place's longitude (degrees East) from its
+// human-readable names. The result's Provider is the source ("gazetteer" or a
+// geocoder name) and Level says whether the district or only the city was resolved.
 //
 // Implementation strategy:
-//   - Use AMap (高德) geocoding only. If it fails, the caller should fall back to
-//     Beijing time (no longitude correction) and surface the AMap error.
-func ResolveCityLongitude(ctx context.Context, provinceName, cityName string) (float64, error) {
-	provinceName = normalizeAdminName(provinceName)
-	origCityName := strings.TrimSpace(cityName)
-	cityName = normalizeAdminName(cityName)
-	if cityName == "" && origCityName != "" {
-		cityName = origCityName
+//   - For China (incl. HK/MO/TW), look the district, then the city, up in the
+//     embedded gazetteer; this works offline and without credentials.
+//   - A district missing from the gazetteer goes to the geocoder chain configured by
+//     NewGeocoderFromEnv before settling for the city seat, since counties of large
+//     prefectures can be more than a degree apart.
+//   - If everything fails, the caller should fall back to zone standard time (no
+//     longitude correction) and surface the error.
+func ResolveCityLongitude(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	q.Country = normalizeCountry(q.Country)
+	q.Province = normalizeAdminName(q.Province)
+	origCityName := strings.TrimSpace(q.City)
+	q.City = normalizeAdminName(q.City)
+	if q.City == "" && origCityName != "" {
+		q.City = origCityName
+	}
+	q.District = strings.TrimSpace(q.District)
+
+	var gz *gazetteerIndex
+	if chinaMapCovers(q.Country) {
+		if g, err := loadGazetteer(); err == nil {
+			gz = g
+		}
 	}
-	if strings.TrimSpace(cityName) == "" {
-		return 0, fmt.Errorf("city name is empty")
+
+	if q.District != "" {
+		if gz != nil {
+			if e, ok := gz.lookupDistrict(q.Province, q.City, q.District); ok {
+				return GeoResult{Longitude: e.lon, Latitude: e.lat, Provider: "gazetteer", Level: "district"}, nil
+			}
+		}
+		if res, err := geocodeCached(ctx, q); err == nil {
+			res.Level = "district"
+			return res, nil
+		}
 	}
 
-	cacheKey := provinceName + "|" + cityName
-	if v, ok := geoCache.Load(cacheKey); ok {
-		return v.(float64), nil
+	if gz != nil {
+		if e, ok := gz.lookupCity(q.Province, q.City); ok {
+			return GeoResult{Longitude: e.lon, Latitude: e.lat, Provider: "gazetteer", Level: "city"}, nil
+		}
 	}
 
-	key := strings.TrimSpace(os.Getenv("AMAP_KEY"))
-	// Be tolerant of quotes if users set AMAP_KEY in an env file like AMAP_KEY="xxx".
-	key = strings.Trim(key, "\"'")
-	if key == "" {
-		return 0, fmt.Errorf("amap_key_missing")
+	if strings.TrimSpace(q.City) == "" {
+		return GeoResult{}, fmt.Errorf("city name is empty")
 	}
-	lon, err := resolveCityLongitudeAMap(ctx, key, provinceName, cityName)
+	q.District = ""
+	res, err := geocodeCached(ctx, q)
 	if err != nil {
-		return 0, fmt.Errorf("amap_api_failed(%s): %w", keyHint(key), err)
+		return GeoResult{}, fmt.Errorf("gazetteer_miss; %w", err)
 	}
-	geoCache.Store(cacheKey, lon)
-	return lon, nil
+	res.Level = "city"
+	return res, nil
+}
+
+// geocodeCached runs the default geocoder chain through the geocode cache.
+func geocodeCached(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	if cityPlaceholders[q.City] {
+		// Municipalities and county-level cities directly under a province: the
+		// district name alone is meaningful once prefixed by the province.
+		q.City = q.Province
+	}
+	cacheKey := q.Country + "|" + q.Province + "|" + q.City
+	if q.District != "" {
+		cacheKey += "|" + q.District
+	}
+	return geoCache.Resolve(ctx, cacheKey, func(ctx context.Context) (GeoResult, error) {
+		return defaultGeocoder().Geocode(ctx, q)
+	})
 }
 
-var geoCache sync.Map // key -> float64 longitude
+// defaultGeocoder is built once from the environment on first use.
+var defaultGeocoder = sync.OnceValue(func() Geocoder { return NewGeocoderFromEnv() })
 
 func keyHint(key string) string {
 	// Do not leak the full key. Provide a small fingerprint for debugging.
@@ -63,79 +96,6 @@ func keyHint(key string) string {
 	return fmt.Sprintf("key_len=%d,last4=%s", n, last4)
 }
 
-// ----------------------------
-// AMap geocoding (CN, requires key)
-// ----------------------------
-
-type amapGeoResp struct {
-	Status   string `json:"status"`
-	Info     string `json:"info"`
-	Infocode string `json:"infocode"`
-	Geocodes []struct {
-		Location string `json:"location"` // "lng,lat"
-	} `json:"geocodes"`
-}
-
-func resolveCityLongitudeAMap(ctx context.Context, key, provinceName, cityName string) (float64, error) {
-	base := "https://restapi.amap.com/v3/geocode/geo"
-	q := url.Values{}
-	q.Set("key", key)
-	q.Set("address", cityName)
-	if provinceName != "" {
-		q.Set("city", provinceName)
-	}
-	u := base + "?" + q.Encode()
-
-	client := &http.Client{
-		Transport: &http.Transport{
-			Proxy: http.ProxyFromEnvironment,
-			DialContext: (&net.Dialer{
-				Timeout:   5 * time.Second,
-				KeepAlive: 30 * time.Second,
-			}).DialContext,
-			ForceAttemptHTTP2:     true,
-			MaxIdleConns:          20,
-			IdleConnTimeout:       60 * time.Second,
-			TLSHandshakeTimeout:   5 * time.Second,
-			ExpectContinueTimeout: 1 * time.Second,
-		},
-		Timeout: 6 * time.Second,
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
-	if err != nil {
-		return 0, err
-	}
-	resp, err := client.Do(req)
-	if err != nil {
-		return 0, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode/100 != 2 {
-		return 0, fmt.Errorf("amap http %d", resp.StatusCode)
-	}
-
-	var out amapGeoResp
-	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
-		return 0, err
-	}
-	if out.Status != "1" {
-		return 0, fmt.Errorf("amap error: %s (%s)", out.Info, out.Infocode)
-	}
-	if len(out.Geocodes) == 0 || strings.TrimSpace(out.Geocodes[0].Location) == "" {
-		return 0, fmt.Errorf("amap empty")
-	}
-	parts := strings.Split(strings.TrimSpace(out.Geocodes[0].Location), ",")
-	if len(parts) < 1 {
-		return 0, fmt.Errorf("amap invalid location")
-	}
-	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
-	if err != nil {
-		return 0, fmt.Errorf("amap invalid longitude")
-	}
-	return lon, nil
-}
-
 func normalizeAdminName(s string) string {
 	s = strings.TrimSpace(s)
 	// Remove common Chinese administrative suffixes (longer ones first).
@@ -0,0 +1,407 @@
+package bazi
+
+import (
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	pb "llyb-backend/proto"
+)
+
+// Geocode cache: an in-memory LRU in front of an optional MySQL table (geo_cache),
+// both honouring the same TTL. Concurrent misses for one key share a single lookup.
+//
+// Configuration (env):
+//   - GEO_CACHE_TTL: Go duration, default 720h (30 days)
+//   - GEO_CACHE_SIZE: LRU capacity, default 4096
+
+const (
+	defaultGeoCacheTTL  = 30 * 24 * time.Hour
+	defaultGeoCacheSize = 4096
+)
+
+// GeoCacheEntry is one cached geocoding result.
+type GeoCacheEntry struct {
+	Key       string
+	Result    GeoResult
+	CreatedAt time.Time
+	ExpiresAt time.Time
+	InMemory  bool
+}
+
+// GeoCache is the two-tier geocode cache.
+type GeoCache struct {
+	ttl   time.Duration
+	lru   *geoLRU
+	group singleflight.Group
+
+	mu sync.RWMutex
+	db *sql.DB // nil: memory only
+}
+
+// geoCache is the process-wide cache used by ResolveCityLongitude.
+var geoCache = newGeoCacheFromEnv()
+
+// DefaultGeoCache returns the process-wide geocode cache.
+func DefaultGeoCache() *GeoCache { return geoCache }
+
+// ConfigureGeoCacheDB attaches the MySQL tier to the process-wide cache. The table
+// must exist (see appinit.EnsureGeoCacheTable).
+func ConfigureGeoCacheDB(db *sql.DB) {
+	geoCache.mu.Lock()
+	geoCache.db = db
+	geoCache.mu.Unlock()
+}
+
+func newGeoCacheFromEnv() *GeoCache {
+	ttl := defaultGeoCacheTTL
+	if v := strings.TrimSpace(os.Getenv("GEO_CACHE_TTL")); v != "" {
+		if d, err := time.ParseDuration(v); err == nil && d > 0 {
+			ttl = d
+		}
+	}
+	size := defaultGeoCacheSize
+	if v := strings.TrimSpace(os.Getenv("GEO_CACHE_SIZE")); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			size = n
+		}
+	}
+	return &GeoCache{ttl: ttl, lru: newGeoLRU(size)}
+}
+
+// TTL reports how long entries stay valid.
+func (c *GeoCache) TTL() time.Duration { return c.ttl }
+
+// MemoryStats reports the LRU occupancy.
+func (c *GeoCache) MemoryStats() (size, capacity int) { return c.lru.stats() }
+
+func (c *GeoCache) store() *sql.DB {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.db
+}
+
+// Resolve returns the cached result for key, or calls fetch once for all concurrent
+// callers and caches its result in both tiers. Errors are not cached.
+func (c *GeoCache) Resolve(ctx context.Context, key string, fetch func(context.Context) (GeoResult, error)) (GeoResult, error) {
+	now := time.Now()
+	if e, ok := c.lru.get(key, now); ok {
+		return e.Result, nil
+	}
+
+	// The shared lookup must not die with whichever caller happened to start it;
+	// each geocoder still applies its own timeout.
+	ch := c.group.DoChan(key, func() (any, error) {
+		sctx := context.WithoutCancel(ctx)
+		if db := c.store(); db != nil {
+			e, err := geoCacheSelect(sctx, db, key, time.Now())
+			if err == nil {
+				c.lru.put(e)
+				return e.Result, nil
+			}
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.Printf("geo cache read failed: key=%q err=%v", key, err)
+			}
+		}
+		res, err := fetch(sctx)
+		if err != nil {
+			return GeoResult{}, err
+		}
+		now := time.Now()
+		e := GeoCacheEntry{Key: key, Result: res, CreatedAt: now, ExpiresAt: now.Add(c.ttl)}
+		c.lru.put(e)
+		if db := c.store(); db != nil {
+			if err := geoCacheUpsert(sctx, db, e); err != nil {
+				log.Printf("geo cache write failed: key=%q err=%v", key, err)
+			}
+		}
+		return res, nil
+	})
+	select {
+	case r := <-ch:
+		if r.Err != nil {
+			return GeoResult{}, r.Err
+		}
+		return r.Val.(GeoResult), nil
+	case <-ctx.Done():
+		return GeoResult{}, ctx.Err()
+	}
+}
+
+// List returns entries whose key contains keyword, newest first, with the total
+// number of matches. With MySQL configured it lists the persistent tier (marking
+// entries also held in memory); otherwise it lists the LRU.
+func (c *GeoCache) List(ctx context.Context, keyword string, offset, limit int) ([]GeoCacheEntry, int, error) {
+	db := c.store()
+	if db == nil {
+		all := c.lru.list(keyword)
+		total := len(all)
+		if offset >= total {
+			return nil, total, nil
+		}
+		return all[offset:min(offset+limit, total)], total, nil
+	}
+
+	like := "%" + escapeLike(keyword) + "%"
+	var total int
+	if err := db.QueryRowContext(ctx, `SELECT COUNT(*) FROM geo_cache WHERE cache_key LIKE ?`, like).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+	rows, err := db.QueryContext(ctx, `
+SELECT cache_key, longitude, latitude, provider, created_at, expires_at
+FROM geo_cache WHERE cache_key LIKE ?
+ORDER BY created_at DESC, cache_key LIMIT ? OFFSET ?`, like, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var out []GeoCacheEntry
+	for rows.Next() {
+		var e GeoCacheEntry
+		if err := rows.Scan(&e.Key, &e.Result.Longitude, &e.Result.Latitude, &e.Result.Provider, &e.CreatedAt, &e.ExpiresAt); err != nil {
+			return nil, 0, err
+		}
+		e.InMemory = c.lru.contains(e.Key)
+		out = append(out, e)
+	}
+	return out, total, rows.Err()
+}
+
+// PurgeKey removes one key from both tiers.
+func (c *GeoCache) PurgeKey(ctx context.Context, key string) (int64, error) {
+	return c.purge(ctx, func(e GeoCacheEntry) bool { return e.Key == key },
+		`DELETE FROM geo_cache WHERE cache_key = ?`, key)
+}
+
+// PurgeExpired removes expired entries from both tiers.
+func (c *GeoCache) PurgeExpired(ctx context.Context) (int64, error) {
+	now := time.Now()
+	return c.purge(ctx, func(e GeoCacheEntry) bool { return !now.Before(e.ExpiresAt) },
+		`DELETE FROM geo_cache WHERE expires_at <= ?`, now)
+}
+
+// PurgeAll empties both tiers.
+func (c *GeoCache) PurgeAll(ctx context.Context) (int64, error) {
+	return c.purge(ctx, func(GeoCacheEntry) bool { return true }, `DELETE FROM geo_cache`)
+}
+
+// purge drops matching LRU entries and runs the DELETE. The count is the MySQL rows
+// affected, or the LRU entries dropped when MySQL is not configured.
+func (c *GeoCache) purge(ctx context.Context, match func(GeoCacheEntry) bool, query string, args ...any) (int64, error) {
+	dropped := int64(c.lru.removeIf(match))
+	db := c.store()
+	if db == nil {
+		return dropped, nil
+	}
+	res, err := db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func geoCacheSelect(ctx context.Context, db *sql.DB, key string, now time.Time) (GeoCacheEntry, error) {
+	e := GeoCacheEntry{Key: key}
+	err := db.QueryRowContext(ctx, `
+SELECT longitude, latitude, provider, created_at, expires_at
+FROM geo_cache WHERE cache_key = ? AND expires_at > ?`, key, now).
+		Scan(&e.Result.Longitude, &e.Result.Latitude, &e.Result.Provider, &e.CreatedAt, &e.ExpiresAt)
+	return e, err
+}
+
+func geoCacheUpsert(ctx context.Context, db *sql.DB, e GeoCacheEntry) error {
+	_, err := db.ExecContext(ctx, `
+INSERT INTO geo_cache (cache_key, longitude, latitude, provider, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE longitude = VALUES(longitude), latitude = VALUES(latitude),
+  provider = VALUES(provider), created_at = VALUES(created_at), expires_at = VALUES(expires_at)`,
+		e.Key, e.Result.Longitude, e.Result.Latitude, e.Result.Provider, e.CreatedAt, e.ExpiresAt)
+	return err
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// ----------------------------
+// In-memory LRU
+// ----------------------------
+
+type geoLRU struct {
+	mu    sync.Mutex
+	cap   int
+	ll    *list.List // front = most recently used; values are GeoCacheEntry
+	items map[string]*list.Element
+}
+
+func newGeoLRU(capacity int) *geoLRU {
+	return &geoLRU{cap: capacity, ll: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (l *geoLRU) get(key string, now time.Time) (GeoCacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	el, ok := l.items[key]
+	if !ok {
+		return GeoCacheEntry{}, false
+	}
+	e := el.Value.(GeoCacheEntry)
+	if !now.Before(e.ExpiresAt) {
+		l.ll.Remove(el)
+		delete(l.items, key)
+		return GeoCacheEntry{}, false
+	}
+	l.ll.MoveToFront(el)
+	return e, true
+}
+
+func (l *geoLRU) put(e GeoCacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	e.InMemory = true
+	if el, ok := l.items[e.Key]; ok {
+		el.Value = e
+		l.ll.MoveToFront(el)
+		return
+	}
+	l.items[e.Key] = l.ll.PushFront(e)
+	for l.ll.Len() > l.cap {
+		last := l.ll.Back()
+		l.ll.Remove(last)
+		delete(l.items, last.Value.(GeoCacheEntry).Key)
+	}
+}
+
+func (l *geoLRU) contains(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, ok := l.items[key]
+	return ok
+}
+
+// list returns entries whose key contains keyword, most recently used first.
+func (l *geoLRU) list(keyword string) []GeoCacheEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var out []GeoCacheEntry
+	for el := l.ll.Front(); el != nil; el = el.Next() {
+		e := el.Value.(GeoCacheEntry)
+		if strings.Contains(e.Key, keyword) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+func (l *geoLRU) removeIf(match func(GeoCacheEntry) bool) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := 0
+	for el := l.ll.Front(); el != nil; {
+		next := el.Next()
+		if e := el.Value.(GeoCacheEntry); match(e) {
+			l.ll.Remove(el)
+			delete(l.items, e.Key)
+			n++
+		}
+		el = next
+	}
+	return n
+}
+
+func (l *geoLRU) stats() (size, capacity int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.ll.Len(), l.cap
+}
+
+// ----------------------------
+// Admin handlers
+// ----------------------------
+
+const (
+	defaultGeoCachePageSize = 50
+	maxGeoCachePageSize     = 500
+)
+
+// GeoCacheList serves /admin/geo-cache/list.
+func GeoCacheList(ctx context.Context, req *pb.GeoCacheListRequest) (*pb.GeoCacheListResponse, error) {
+	page := int(req.GetPage())
+	if page <= 0 {
+		page = 1
+	}
+	size := int(req.GetPageSize())
+	if size <= 0 {
+		size = defaultGeoCachePageSize
+	}
+	if size > maxGeoCachePageSize {
+		size = maxGeoCachePageSize
+	}
+
+	entries, total, err := geoCache.List(ctx, strings.TrimSpace(req.GetKeyword()), (page-1)*size, size)
+	if err != nil {
+		return nil, err
+	}
+	memSize, memCap := geoCache.MemoryStats()
+	resp := &pb.GeoCacheListResponse{
+		Code:           0,
+		Message:        "ok",
+		Total:          int32(total),
+		MemorySize:     int32(memSize),
+		MemoryCapacity: int32(memCap),
+		TtlSeconds:     int64(geoCache.TTL() / time.Second),
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &pb.GeoCacheEntry{
+			Key:       e.Key,
+			Longitude: e.Result.Longitude,
+			Latitude:  e.Result.Latitude,
+			Provider:  e.Result.Provider,
+			CreatedAt: e.CreatedAt.In(beijing).Format("2006-01-02 15:04:05"),
+			ExpiresAt: e.ExpiresAt.In(beijing).Format("2006-01-02 15:04:05"),
+			InMemory:  e.InMemory,
+		})
+	}
+	return resp, nil
+}
+
+// GeoCachePurge serves /admin/geo-cache/purge.
+func GeoCachePurge(ctx context.Context, req *pb.GeoCachePurgeRequest) (*pb.GeoCachePurgeResponse, error) {
+	key := strings.TrimSpace(req.GetKey())
+	modes := 0
+	for _, set := range []bool{key != "", req.GetExpiredOnly(), req.GetAll()} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return &pb.GeoCachePurgeResponse{
+			Code:    1002,
+			Message: "key、expired_only、all 须且只能指定一个",
+		}, nil
+	}
+
+	var n int64
+	var err error
+	switch {
+	case key != "":
+		n, err = geoCache.PurgeKey(ctx, key)
+	case req.GetExpiredOnly():
+		n, err = geoCache.PurgeExpired(ctx)
+	default:
+		n, err = geoCache.PurgeAll(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GeoCachePurgeResponse{Code: 0, Message: "ok", Purged: n}, nil
+}
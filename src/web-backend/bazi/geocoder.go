@@ -0,0 +1,206 @@
+package bazi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// GeoQuery is a human-readable place to geocode.
+type GeoQuery struct {
+	Country  string // normalized ISO 3166-1 alpha-2 code, see normalizeCountry
+	Province string
+	City     string
+	District string // optional county/district
+}
+
+// GeoResult is a geocoded coordinate (WGS84-ish degrees; the GCJ-02/BD-09 offsets
+// of Chinese providers are far below what matters for solar time).
+type GeoResult struct {
+	Longitude float64
+	Latitude  float64
+	Provider  string
+	// Level is the finest administrative level the coordinate stands for:
+	// "district", "city" or "input" (caller-supplied coordinates).
+	Level string
+}
+
+// Geocoder resolves a place name to coordinates.
+type Geocoder interface {
+	// Name identifies the provider, e.g. "amap"; it is reported as longitude_source.
+	Name() string
+	Geocode(ctx context.Context, q GeoQuery) (GeoResult, error)
+}
+
+// Error classes for geocoding failures. Provider errors wrap exactly one of them,
+// so callers can branch with errors.Is.
+var (
+	ErrGeoNotFound      = errors.New("not_found")
+	ErrGeoQuotaExceeded = errors.New("quota_exceeded")
+	ErrGeoNetwork       = errors.New("network_error")
+	ErrGeoAuth          = errors.New("auth_failed")
+	ErrGeoUnsupported   = errors.New("unsupported_region")
+	ErrGeoProvider      = errors.New("provider_error")
+)
+
+// GeoError is a classified error from one provider.
+type GeoError struct {
+	Provider string
+	Kind     error // one of the ErrGeo* values
+	Detail   string
+}
+
+func (e *GeoError) Error() string {
+	if e.Detail == "" {
+		return fmt.Sprintf("%s: %v", e.Provider, e.Kind)
+	}
+	return fmt.Sprintf("%s: %v (%s)", e.Provider, e.Kind, e.Detail)
+}
+
+func (e *GeoError) Unwrap() error { return e.Kind }
+
+func geoErr(provider string, kind error, format string, args ...any) error {
+	return &GeoError{Provider: provider, Kind: kind, Detail: fmt.Sprintf(format, args...)}
+}
+
+// GeocoderChain tries each geocoder in order and returns the first success.
+// Every failure moves on to the next provider, since coverage differs between them.
+type GeocoderChain []Geocoder
+
+func (c GeocoderChain) Name() string { return "chain" }
+
+func (c GeocoderChain) Geocode(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	if len(c) == 0 {
+		return GeoResult{}, &GeoError{Provider: "chain", Kind: ErrGeoUnsupported, Detail: "no geocoder configured"}
+	}
+	var errs []error
+	for _, g := range c {
+		res, err := g.Geocode(ctx, q)
+		if err == nil {
+			return res, nil
+		}
+		errs = append(errs, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return GeoResult{}, errors.Join(errs...)
+}
+
+// Default per-provider request timeout.
+const defaultGeoTimeout = 6 * time.Second
+
+// geoHTTPClient is shared by all providers; request deadlines come from the context.
+var geoHTTPClient = &http.Client{
+	Transport: &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   5 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          20,
+		IdleConnTimeout:       60 * time.Second,
+		TLSHandshakeTimeout:   5 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	},
+}
+
+// geoGet performs a GET with the provider's timeout and classifies transport-level
+// failures. The caller owns the returned response body.
+func geoGet(ctx context.Context, provider string, client *http.Client, timeout time.Duration, u string, header http.Header) (*http.Response, context.CancelFunc, error) {
+	if client == nil {
+		client = geoHTTPClient
+	}
+	if timeout <= 0 {
+		timeout = defaultGeoTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		cancel()
+		return nil, nil, geoErr(provider, ErrGeoProvider, "%v", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		cancel()
+		return nil, nil, geoErr(provider, ErrGeoNetwork, "%v", err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		resp.Body.Close()
+		cancel()
+		return nil, nil, geoErr(provider, ErrGeoQuotaExceeded, "http %d", resp.StatusCode)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		resp.Body.Close()
+		cancel()
+		return nil, nil, geoErr(provider, ErrGeoAuth, "http %d", resp.StatusCode)
+	case resp.StatusCode/100 == 5:
+		resp.Body.Close()
+		cancel()
+		return nil, nil, geoErr(provider, ErrGeoNetwork, "http %d", resp.StatusCode)
+	case resp.StatusCode/100 != 2:
+		resp.Body.Close()
+		cancel()
+		return nil, nil, geoErr(provider, ErrGeoProvider, "http %d", resp.StatusCode)
+	}
+	return resp, cancel, nil
+}
+
+// chinaMapCovers reports whether the Chinese map providers (AMap, Tencent, Baidu)
+// cover the country.
+func chinaMapCovers(country string) bool {
+	switch country {
+	case "", "CN", "HK", "MO", "TW":
+		return true
+	}
+	return false
+}
+
+// envKey reads a credential from the environment, tolerating quotes as in KEY="xxx".
+func envKey(name string) string {
+	return strings.Trim(strings.TrimSpace(os.Getenv(name)), "\"'")
+}
+
+// NewGeocoderFromEnv builds the failover chain from environment variables.
+//
+//   - GEOCODERS: ordered provider list, default "amap,tencent,baidu". Nominatim is
+//     opt-in ("amap,tencent,baidu,nominatim"): the public instance would receive
+//     every birth place the keyed providers fail on.
+//   - AMAP_KEY, TENCENT_MAP_KEY, BAIDU_MAP_AK: provider credentials; providers
+//     without credentials are skipped
+//   - NOMINATIM_URL: base URL of a Nominatim instance (default: the public OSM one)
+func NewGeocoderFromEnv() GeocoderChain {
+	order := strings.TrimSpace(os.Getenv("GEOCODERS"))
+	if order == "" {
+		order = "amap,tencent,baidu"
+	}
+	var chain GeocoderChain
+	for _, name := range strings.Split(order, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "amap":
+			if key := envKey("AMAP_KEY"); key != "" {
+				chain = append(chain, &AMapGeocoder{Key: key})
+			}
+		case "tencent":
+			if key := envKey("TENCENT_MAP_KEY"); key != "" {
+				chain = append(chain, &TencentGeocoder{Key: key})
+			}
+		case "baidu":
+			if ak := envKey("BAIDU_MAP_AK"); ak != "" {
+				chain = append(chain, &BaiduGeocoder{AK: ak})
+			}
+		case "nominatim":
+			chain = append(chain, &NominatimGeocoder{BaseURL: strings.TrimSpace(os.Getenv("NOMINATIM_URL"))})
+		}
+	}
+	return chain
+}
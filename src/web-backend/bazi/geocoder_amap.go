@@ -0,0 +1,94 @@
+package bazi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AMapGeocoder uses the AMap (高德) geocoding API. China only; requires a key.
+type AMapGeocoder struct {
+	Key     string
+	BaseURL string        // default "https://restapi.amap.com"
+	Timeout time.Duration // per request; default defaultGeoTimeout
+	Client  *http.Client  // default geoHTTPClient
+}
+
+type amapGeoResp struct {
+	Status   string `json:"status"`
+	Info     string `json:"info"`
+	Infocode string `json:"infocode"`
+	Geocodes []struct {
+		Location string `json:"location"` // "lng,lat"
+	} `json:"geocodes"`
+}
+
+func (g *AMapGeocoder) Name() string { return "amap" }
+
+func (g *AMapGeocoder) Geocode(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	if !chinaMapCovers(q.Country) {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoUnsupported, "country %s", q.Country)
+	}
+	if strings.TrimSpace(q.City) == "" {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "city name is empty")
+	}
+	base := g.BaseURL
+	if base == "" {
+		base = "https://restapi.amap.com"
+	}
+	v := url.Values{}
+	v.Set("key", g.Key)
+	v.Set("address", q.City+q.District)
+	if q.Province != "" {
+		v.Set("city", q.Province)
+	}
+
+	resp, cancel, err := geoGet(ctx, g.Name(), g.Client, g.Timeout, strings.TrimRight(base, "/")+"/v3/geocode/geo?"+v.Encode(), nil)
+	if err != nil {
+		return GeoResult{}, err
+	}
+	defer cancel()
+	defer resp.Body.Close()
+
+	var out amapGeoResp
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoProvider, "invalid json: %v", err)
+	}
+	if out.Status != "1" {
+		return GeoResult{}, geoErr(g.Name(), amapErrorKind(out.Infocode), "%s (%s) %s", out.Info, out.Infocode, keyHint(g.Key))
+	}
+	if len(out.Geocodes) == 0 || strings.TrimSpace(out.Geocodes[0].Location) == "" {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "empty result")
+	}
+	lon, lat, ok := parseLngLat(out.Geocodes[0].Location)
+	if !ok {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoProvider, "invalid location %q", out.Geocodes[0].Location)
+	}
+	return GeoResult{Longitude: lon, Latitude: lat, Provider: g.Name()}, nil
+}
+
+// amapErrorKind classifies AMap infocodes.
+func amapErrorKind(infocode string) error {
+	switch infocode {
+	case "10003", "10004", "10014", "10019", "10020", "10021", "10044", "10045":
+		return ErrGeoQuotaExceeded
+	case "10001", "10002", "10005", "10006", "10007", "10008", "10009", "10010", "10011", "10012", "10013":
+		return ErrGeoAuth
+	}
+	return ErrGeoProvider
+}
+
+// parseLngLat parses "lng,lat".
+func parseLngLat(s string) (lon, lat float64, ok bool) {
+	parts := strings.Split(strings.TrimSpace(s), ",")
+	if len(parts) != 2 {
+		return 0, 0, false
+	}
+	lon, err1 := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	lat, err2 := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	return lon, lat, err1 == nil && err2 == nil
+}
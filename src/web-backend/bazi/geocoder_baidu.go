@@ -0,0 +1,90 @@
+package bazi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// BaiduGeocoder uses the Baidu Maps geocoding v3 API. China only; requires an AK.
+type BaiduGeocoder struct {
+	AK      string
+	BaseURL string        // default "https://api.map.baidu.com"
+	Timeout time.Duration // per request; default defaultGeoTimeout
+	Client  *http.Client  // default geoHTTPClient
+}
+
+type baiduGeoResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Msg     string `json:"msg"`
+	Result  struct {
+		Location struct {
+			Lng float64 `json:"lng"`
+			Lat float64 `json:"lat"`
+		} `json:"location"`
+	} `json:"result"`
+}
+
+func (g *BaiduGeocoder) Name() string { return "baidu" }
+
+func (g *BaiduGeocoder) Geocode(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	if !chinaMapCovers(q.Country) {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoUnsupported, "country %s", q.Country)
+	}
+	if strings.TrimSpace(q.City) == "" {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "city name is empty")
+	}
+	base := g.BaseURL
+	if base == "" {
+		base = "https://api.map.baidu.com"
+	}
+	v := url.Values{}
+	v.Set("ak", g.AK)
+	v.Set("output", "json")
+	v.Set("address", q.Province+q.City+q.District)
+	if q.Province != "" {
+		v.Set("city", q.Province)
+	}
+
+	resp, cancel, err := geoGet(ctx, g.Name(), g.Client, g.Timeout, strings.TrimRight(base, "/")+"/geocoding/v3/?"+v.Encode(), nil)
+	if err != nil {
+		return GeoResult{}, err
+	}
+	defer cancel()
+	defer resp.Body.Close()
+
+	var out baiduGeoResp
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoProvider, "invalid json: %v", err)
+	}
+	if out.Status != 0 {
+		msg := out.Message
+		if msg == "" {
+			msg = out.Msg
+		}
+		return GeoResult{}, geoErr(g.Name(), baiduErrorKind(out.Status), "%s (%d)", msg, out.Status)
+	}
+	loc := out.Result.Location
+	if loc.Lat == 0 && loc.Lng == 0 {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "empty result")
+	}
+	return GeoResult{Longitude: loc.Lng, Latitude: loc.Lat, Provider: g.Name()}, nil
+}
+
+// baiduErrorKind classifies Baidu Maps status codes.
+func baiduErrorKind(status int) error {
+	switch {
+	case status == 302 || status == 401 || status == 4:
+		return ErrGeoQuotaExceeded
+	case status == 101 || status == 102 || (status >= 200 && status < 300):
+		return ErrGeoAuth
+	case status == 1:
+		// "服务器内部错误" is also what Baidu returns for unknown addresses.
+		return ErrGeoNotFound
+	}
+	return ErrGeoProvider
+}
@@ -0,0 +1,76 @@
+package bazi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NominatimGeocoder uses an OpenStreetMap Nominatim instance. Worldwide, no key,
+// but the public instance allows about one request per second.
+type NominatimGeocoder struct {
+	BaseURL   string        // default "https://nominatim.openstreetmap.org"
+	UserAgent string        // required by the OSM usage policy; default "llyb-backend"
+	Timeout   time.Duration // per request; default defaultGeoTimeout
+	Client    *http.Client  // default geoHTTPClient
+}
+
+type nominatimPlace struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
+
+func (g *NominatimGeocoder) Name() string { return "nominatim" }
+
+func (g *NominatimGeocoder) Geocode(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	var parts []string
+	for _, p := range []string{q.District, q.City, q.Province} {
+		if p = strings.TrimSpace(p); p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if len(parts) == 0 {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "empty address")
+	}
+	base := g.BaseURL
+	if base == "" {
+		base = "https://nominatim.openstreetmap.org"
+	}
+	ua := g.UserAgent
+	if ua == "" {
+		ua = "llyb-backend"
+	}
+	v := url.Values{}
+	v.Set("q", strings.Join(parts, ", "))
+	v.Set("format", "jsonv2")
+	v.Set("limit", "1")
+	if q.Country != "" {
+		v.Set("countrycodes", strings.ToLower(q.Country))
+	}
+
+	resp, cancel, err := geoGet(ctx, g.Name(), g.Client, g.Timeout, strings.TrimRight(base, "/")+"/search?"+v.Encode(),
+		http.Header{"User-Agent": {ua}, "Accept-Language": {"zh-CN,en"}})
+	if err != nil {
+		return GeoResult{}, err
+	}
+	defer cancel()
+	defer resp.Body.Close()
+
+	var out []nominatimPlace
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoProvider, "invalid json: %v", err)
+	}
+	if len(out) == 0 {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "empty result")
+	}
+	lon, err1 := strconv.ParseFloat(out[0].Lon, 64)
+	lat, err2 := strconv.ParseFloat(out[0].Lat, 64)
+	if err1 != nil || err2 != nil {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoProvider, "invalid location %q,%q", out[0].Lon, out[0].Lat)
+	}
+	return GeoResult{Longitude: lon, Latitude: lat, Provider: g.Name()}, nil
+}
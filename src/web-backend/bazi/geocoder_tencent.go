@@ -0,0 +1,80 @@
+package bazi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TencentGeocoder uses the Tencent Maps (腾讯位置服务) WebService geocoder. China only.
+type TencentGeocoder struct {
+	Key     string
+	BaseURL string        // default "https://apis.map.qq.com"
+	Timeout time.Duration // per request; default defaultGeoTimeout
+	Client  *http.Client  // default geoHTTPClient
+}
+
+type tencentGeoResp struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	Result  struct {
+		Location struct {
+			Lat float64 `json:"lat"`
+			Lng float64 `json:"lng"`
+		} `json:"location"`
+	} `json:"result"`
+}
+
+func (g *TencentGeocoder) Name() string { return "tencent" }
+
+func (g *TencentGeocoder) Geocode(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	if !chinaMapCovers(q.Country) {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoUnsupported, "country %s", q.Country)
+	}
+	if strings.TrimSpace(q.City) == "" {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "city name is empty")
+	}
+	base := g.BaseURL
+	if base == "" {
+		base = "https://apis.map.qq.com"
+	}
+	v := url.Values{}
+	v.Set("key", g.Key)
+	v.Set("address", q.Province+q.City+q.District)
+
+	resp, cancel, err := geoGet(ctx, g.Name(), g.Client, g.Timeout, strings.TrimRight(base, "/")+"/ws/geocoder/v1/?"+v.Encode(), nil)
+	if err != nil {
+		return GeoResult{}, err
+	}
+	defer cancel()
+	defer resp.Body.Close()
+
+	var out tencentGeoResp
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoProvider, "invalid json: %v", err)
+	}
+	if out.Status != 0 {
+		return GeoResult{}, geoErr(g.Name(), tencentErrorKind(out.Status), "%s (%d)", out.Message, out.Status)
+	}
+	loc := out.Result.Location
+	if loc.Lat == 0 && loc.Lng == 0 {
+		return GeoResult{}, geoErr(g.Name(), ErrGeoNotFound, "empty result")
+	}
+	return GeoResult{Longitude: loc.Lng, Latitude: loc.Lat, Provider: g.Name()}, nil
+}
+
+// tencentErrorKind classifies Tencent Maps status codes.
+func tencentErrorKind(status int) error {
+	switch status {
+	case 120, 121, 122:
+		return ErrGeoQuotaExceeded
+	case 110, 111, 112, 113, 190, 199, 311:
+		return ErrGeoAuth
+	case 347:
+		return ErrGeoNotFound
+	}
+	return ErrGeoProvider
+}
@@ -0,0 +1,172 @@
+package bazi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+var hangzhou = GeoQuery{Country: "CN", Province: "浙江省", City: "杭州市"}
+
+// Canned responses per provider: a hit at 120.15,30.28, an unknown address, and an
+// over-quota answer given with HTTP 200.
+var geocoderCases = []struct {
+	name                   string
+	path                   string
+	newGeocoder            func(baseURL string) Geocoder
+	found, notFound, quota string
+}{
+	{
+		name:        "amap",
+		path:        "/v3/geocode/geo",
+		newGeocoder: func(u string) Geocoder { return &AMapGeocoder{Key: "k", BaseURL: u} },
+		found:       `{"status":"1","info":"OK","infocode":"10000","geocodes":[{"location":"120.150000,30.280000"}]}`,
+		notFound:    `{"status":"1","info":"OK","infocode":"10000","geocodes":[]}`,
+		quota:       `{"status":"0","info":"DAILY_QUERY_OVER_LIMIT","infocode":"10003"}`,
+	},
+	{
+		name:        "tencent",
+		path:        "/ws/geocoder/v1/",
+		newGeocoder: func(u string) Geocoder { return &TencentGeocoder{Key: "k", BaseURL: u} },
+		found:       `{"status":0,"message":"query ok","result":{"location":{"lat":30.28,"lng":120.15}}}`,
+		notFound:    `{"status":347,"message":"查询无结果"}`,
+		quota:       `{"status":121,"message":"此key每日调用量已达到上限"}`,
+	},
+	{
+		name:        "baidu",
+		path:        "/geocoding/v3/",
+		newGeocoder: func(u string) Geocoder { return &BaiduGeocoder{AK: "k", BaseURL: u} },
+		found:       `{"status":0,"result":{"location":{"lng":120.15,"lat":30.28}}}`,
+		notFound:    `{"status":1,"msg":"Internal Service Error:无相关结果"}`,
+		quota:       `{"status":302,"message":"天配额超限，限制访问"}`,
+	},
+	{
+		name:        "nominatim",
+		path:        "/search",
+		newGeocoder: func(u string) Geocoder { return &NominatimGeocoder{BaseURL: u} },
+		found:       `[{"lat":"30.28","lon":"120.15"}]`,
+		notFound:    `[]`,
+		quota:       "", // Nominatim only signals limits through HTTP 429
+	},
+}
+
+func TestGeocoders(t *testing.T) {
+	for _, tc := range geocoderCases {
+		serve := func(status int, body string) Geocoder {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tc.path {
+					http.NotFound(w, r)
+					return
+				}
+				w.WriteHeader(status)
+				io.WriteString(w, body)
+			}))
+			t.Cleanup(srv.Close)
+			return tc.newGeocoder(srv.URL)
+		}
+
+		t.Run(tc.name+"/found", func(t *testing.T) {
+			res, err := serve(http.StatusOK, tc.found).Geocode(context.Background(), hangzhou)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if math.Abs(res.Longitude-120.15) > 1e-9 || math.Abs(res.Latitude-30.28) > 1e-9 || res.Provider != tc.name {
+				t.Errorf("got %+v, want 120.15,30.28 from %s", res, tc.name)
+			}
+		})
+		t.Run(tc.name+"/not_found", func(t *testing.T) {
+			_, err := serve(http.StatusOK, tc.notFound).Geocode(context.Background(), hangzhou)
+			if !errors.Is(err, ErrGeoNotFound) {
+				t.Errorf("got %v, want %v", err, ErrGeoNotFound)
+			}
+		})
+		t.Run(tc.name+"/http_429", func(t *testing.T) {
+			_, err := serve(http.StatusTooManyRequests, "").Geocode(context.Background(), hangzhou)
+			if !errors.Is(err, ErrGeoQuotaExceeded) {
+				t.Errorf("got %v, want %v", err, ErrGeoQuotaExceeded)
+			}
+		})
+		if tc.quota != "" {
+			t.Run(tc.name+"/quota", func(t *testing.T) {
+				_, err := serve(http.StatusOK, tc.quota).Geocode(context.Background(), hangzhou)
+				if !errors.Is(err, ErrGeoQuotaExceeded) {
+					t.Errorf("got %v, want %v", err, ErrGeoQuotaExceeded)
+				}
+			})
+		}
+		t.Run(tc.name+"/network", func(t *testing.T) {
+			srv := httptest.NewServer(http.NotFoundHandler())
+			srv.Close() // nothing listens on the URL any more
+			_, err := tc.newGeocoder(srv.URL).Geocode(context.Background(), hangzhou)
+			if !errors.Is(err, ErrGeoNetwork) {
+				t.Errorf("got %v, want %v", err, ErrGeoNetwork)
+			}
+		})
+	}
+}
+
+// stubGeocoder records its calls in order and answers with res or err.
+type stubGeocoder struct {
+	name  string
+	res   GeoResult
+	err   error
+	calls *[]string
+}
+
+func (s stubGeocoder) Name() string { return s.name }
+
+func (s stubGeocoder) Geocode(ctx context.Context, q GeoQuery) (GeoResult, error) {
+	*s.calls = append(*s.calls, s.name)
+	return s.res, s.err
+}
+
+func TestGeocoderChainOrder(t *testing.T) {
+	var calls []string
+	quota := geoErr("a", ErrGeoQuotaExceeded, "")
+	notFound := geoErr("b", ErrGeoNotFound, "")
+	chain := GeocoderChain{
+		stubGeocoder{name: "a", err: quota, calls: &calls},
+		stubGeocoder{name: "b", err: notFound, calls: &calls},
+		stubGeocoder{name: "c", res: GeoResult{Longitude: 120, Provider: "c"}, calls: &calls},
+		stubGeocoder{name: "d", res: GeoResult{Longitude: 1, Provider: "d"}, calls: &calls},
+	}
+	res, err := chain.Geocode(context.Background(), hangzhou)
+	if err != nil || res.Provider != "c" {
+		t.Fatalf("got %+v, %v; want the result of c", res, err)
+	}
+	if want := []string{"a", "b", "c"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+
+	// When all fail, every provider's error is kept.
+	calls = nil
+	_, err = chain[:2].Geocode(context.Background(), hangzhou)
+	if !errors.Is(err, ErrGeoQuotaExceeded) || !errors.Is(err, ErrGeoNotFound) {
+		t.Errorf("got %v, want both provider errors", err)
+	}
+	if want := []string{"a", "b"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestNewGeocoderFromEnvOmitsNominatimByDefault(t *testing.T) {
+	t.Setenv("GEOCODERS", "")
+	t.Setenv("AMAP_KEY", "k")
+	t.Setenv("TENCENT_MAP_KEY", "")
+	t.Setenv("BAIDU_MAP_AK", "")
+	chain := NewGeocoderFromEnv()
+	if len(chain) != 1 || chain[0].Name() != "amap" {
+		t.Errorf("default chain = %v, want only amap", chain)
+	}
+
+	t.Setenv("GEOCODERS", "nominatim,amap")
+	chain = NewGeocoderFromEnv()
+	if len(chain) != 2 || chain[0].Name() != "nominatim" || chain[1].Name() != "amap" {
+		t.Errorf("GEOCODERS=nominatim,amap gives %v", chain)
+	}
+}
@@ -0,0 +1,223 @@
+package bazi
+
+import "sort"
+
+// Relations between the stems and branches of a chart (合冲刑害破), optionally with
+// a luck (大运) and an annual (流年) pillar alongside the four natal pillars.
+
+// Position identifies where a pillar sits.
+type Position int
+
+const (
+	YearPos Position = iota
+	MonthPos
+	DayPos
+	HourPos
+	LuckPos   // 大运
+	AnnualPos // 流年
+)
+
+var positionNames = [6]string{"year", "month", "day", "hour", "luck", "annual"}
+
+func (p Position) String() string { return positionNames[p] }
+
+// PlacedPillar is a pillar at a position.
+type PlacedPillar struct {
+	Position Position
+	Pillar   Pillar
+}
+
+// Placed returns the natal pillars with their positions.
+func (p FourPillars) Placed() []PlacedPillar {
+	out := make([]PlacedPillar, 0, 4)
+	for i, pl := range p.pillars() {
+		out = append(out, PlacedPillar{Position: Position(i), Pillar: pl})
+	}
+	return out
+}
+
+// InteractionKind classifies a relation.
+type InteractionKind int
+
+const (
+	StemCombination  InteractionKind = iota // 天干五合
+	SixCombination                          // 地支六合
+	ThreeHarmony                            // 地支三合 (complete)
+	HalfHarmony                             // 地支半合 (two branches including the 旺 branch)
+	DirectionalCombo                        // 地支三会
+	Clash                                   // 地支六冲
+	Punishment                              // 地支三刑 (incl. 相刑 pairs and 自刑)
+	Harm                                    // 地支六害
+	Destruction                             // 地支相破
+)
+
+var interactionKindNames = [9]string{"天干五合", "地支六合", "地支三合", "地支半合", "地支三会", "地支六冲", "地支三刑", "地支六害", "地支相破"}
+
+func (k InteractionKind) String() string { return interactionKindNames[k] }
+
+// Interaction is one detected relation.
+type Interaction struct {
+	Kind InteractionKind
+	// Name spells the relation out, e.g. "甲己合土", "子午冲", "寅巳申三刑".
+	Name      string
+	Positions []Position
+	// Element is the element a combination transforms towards; HasElement is false
+	// for clashes, punishments, harms and destructions.
+	Element    Element
+	HasElement bool
+}
+
+// Involves reports whether the interaction includes position p.
+func (in Interaction) Involves(p Position) bool {
+	for _, q := range in.Positions {
+		if q == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Branch groups: each triple lists a 三合 frame (生, 旺, 墓) or a 三会 season.
+var (
+	threeHarmonies = []struct {
+		branches [3]Branch
+		element  Element
+	}{
+		{[3]Branch{8, 0, 4}, Water}, // 申子辰
+		{[3]Branch{11, 3, 7}, Wood}, // 亥卯未
+		{[3]Branch{2, 6, 10}, Fire}, // 寅午戌
+		{[3]Branch{5, 9, 1}, Metal}, // 巳酉丑
+	}
+	directionalCombos = []struct {
+		branches [3]Branch
+		element  Element
+	}{
+		{[3]Branch{2, 3, 4}, Wood},   // 寅卯辰
+		{[3]Branch{5, 6, 7}, Fire},   // 巳午未
+		{[3]Branch{8, 9, 10}, Metal}, // 申酉戌
+		{[3]Branch{11, 0, 1}, Water}, // 亥子丑
+	}
+	// 三刑: 寅巳申 (无恩之刑) and 丑戌未 (恃势之刑); any two of them punish each other.
+	punishmentTriads = [][3]Branch{{2, 5, 8}, {1, 10, 7}}
+	// 子卯 (无礼之刑).
+	punishmentPairs = [][2]Branch{{0, 3}}
+	// 辰午酉亥 punish themselves when doubled (自刑).
+	selfPunishing = []Branch{4, 6, 9, 11}
+	// 子酉 卯午 丑辰 未戌 寅亥 巳申.
+	destructionPairs = [][2]Branch{{0, 9}, {3, 6}, {1, 4}, {7, 10}, {2, 11}, {5, 8}}
+)
+
+// sixCombinationElements maps the lower branch of each 六合 pair to its element:
+// 子丑土 寅亥木 卯戌火 辰酉金 巳申水 午未土.
+var sixCombinationElements = map[Branch]Element{0: Earth, 2: Wood, 3: Fire, 4: Metal, 5: Water, 6: Earth}
+
+// DetectInteractions reports all relations among the given pillars, sorted by kind.
+func DetectInteractions(pillars []PlacedPillar) []Interaction {
+	var out []Interaction
+
+	// Pairwise relations.
+	for i := 0; i < len(pillars); i++ {
+		for j := i + 1; j < len(pillars); j++ {
+			a, b := pillars[i], pillars[j]
+			pos := []Position{a.Position, b.Position}
+
+			if sa, sb := a.Pillar.Stem, b.Pillar.Stem; mod(int(sa)-int(sb), 10) == 5 {
+				lo := min(sa, sb)
+				e := Element(mod(int(lo)+2, 5)) // 甲己土 乙庚金 丙辛水 丁壬木 戊癸火
+				out = append(out, Interaction{Kind: StemCombination, Name: lo.String() + (lo + 5).String() + "合" + e.String(),
+					Positions: pos, Element: e, HasElement: true})
+			}
+
+			ba, bb := a.Pillar.Branch, b.Pillar.Branch
+			lo, hi := min(ba, bb), max(ba, bb)
+			pair := lo.String() + hi.String()
+			if mod(int(ba)+int(bb), 12) == 1 {
+				e := sixCombinationElements[lo]
+				out = append(out, Interaction{Kind: SixCombination, Name: pair + "合" + e.String(),
+					Positions: pos, Element: e, HasElement: true})
+			}
+			if mod(int(ba)-int(bb), 12) == 6 {
+				out = append(out, Interaction{Kind: Clash, Name: pair + "冲", Positions: pos})
+			}
+			if mod(int(ba)+int(bb), 12) == 7 {
+				out = append(out, Interaction{Kind: Harm, Name: pair + "害", Positions: pos})
+			}
+			for _, p := range destructionPairs {
+				if (ba == p[0] && bb == p[1]) || (ba == p[1] && bb == p[0]) {
+					out = append(out, Interaction{Kind: Destruction, Name: pair + "破", Positions: pos})
+				}
+			}
+			for _, p := range punishmentPairs {
+				if (ba == p[0] && bb == p[1]) || (ba == p[1] && bb == p[0]) {
+					out = append(out, Interaction{Kind: Punishment, Name: pair + "刑", Positions: pos})
+				}
+			}
+		}
+	}
+
+	// Branch groups.
+	at := func(b Branch) []Position {
+		var ps []Position
+		for _, p := range pillars {
+			if p.Pillar.Branch == b {
+				ps = append(ps, p.Position)
+			}
+		}
+		return ps
+	}
+	triad := func(bs [3]Branch) string { return bs[0].String() + bs[1].String() + bs[2].String() }
+
+	for _, h := range threeHarmonies {
+		p0, p1, p2 := at(h.branches[0]), at(h.branches[1]), at(h.branches[2])
+		switch {
+		case len(p0) > 0 && len(p1) > 0 && len(p2) > 0:
+			out = append(out, Interaction{Kind: ThreeHarmony, Name: triad(h.branches) + "三合" + h.element.String() + "局",
+				Positions: concatPositions(p0, p1, p2), Element: h.element, HasElement: true})
+		case len(p1) > 0 && len(p0) > 0:
+			out = append(out, Interaction{Kind: HalfHarmony, Name: h.branches[0].String() + h.branches[1].String() + "半合" + h.element.String(),
+				Positions: concatPositions(p0, p1), Element: h.element, HasElement: true})
+		case len(p1) > 0 && len(p2) > 0:
+			out = append(out, Interaction{Kind: HalfHarmony, Name: h.branches[1].String() + h.branches[2].String() + "半合" + h.element.String(),
+				Positions: concatPositions(p1, p2), Element: h.element, HasElement: true})
+		}
+	}
+	for _, d := range directionalCombos {
+		p0, p1, p2 := at(d.branches[0]), at(d.branches[1]), at(d.branches[2])
+		if len(p0) > 0 && len(p1) > 0 && len(p2) > 0 {
+			out = append(out, Interaction{Kind: DirectionalCombo, Name: triad(d.branches) + "三会" + d.element.String(),
+				Positions: concatPositions(p0, p1, p2), Element: d.element, HasElement: true})
+		}
+	}
+	for _, t := range punishmentTriads {
+		ps := [3][]Position{at(t[0]), at(t[1]), at(t[2])}
+		if len(ps[0]) > 0 && len(ps[1]) > 0 && len(ps[2]) > 0 {
+			out = append(out, Interaction{Kind: Punishment, Name: triad(t) + "三刑", Positions: concatPositions(ps[0], ps[1], ps[2])})
+			continue
+		}
+		for i := 0; i < 3; i++ {
+			j := (i + 1) % 3
+			if len(ps[i]) > 0 && len(ps[j]) > 0 {
+				out = append(out, Interaction{Kind: Punishment, Name: t[i].String() + t[j].String() + "相刑", Positions: concatPositions(ps[i], ps[j])})
+			}
+		}
+	}
+	for _, b := range selfPunishing {
+		if ps := at(b); len(ps) >= 2 {
+			out = append(out, Interaction{Kind: Punishment, Name: b.String() + b.String() + "自刑", Positions: ps})
+		}
+	}
+
+	for i := range out {
+		sort.Slice(out[i].Positions, func(a, b int) bool { return out[i].Positions[a] < out[i].Positions[b] })
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Kind < out[j].Kind })
+	return out
+}
+
+func concatPositions(groups ...[]Position) []Position {
+	var out []Position
+	for _, g := range groups {
+		out = append(out, g...)
+	}
+	return out
+}
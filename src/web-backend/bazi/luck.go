@@ -0,0 +1,116 @@
+package bazi
+
+import "time"
+
+// Luck pillars (大运) and annual pillars (流年).
+//
+// Luck pillars step from the month pillar, forward (顺排) for a yang-year male or a
+// yin-year female and backward (逆排) otherwise, one pillar per ten years. The first
+// one starts after the time from birth to the next 节 (forward) or since the previous
+// 节 (backward), scaled by the classical rule 3 days = 1 year, 1 day = 4 months,
+// 1 时辰 = 10 days.
+
+// MaxLuckPillars bounds the luck pillars produced for one chart (120 years).
+const MaxLuckPillars = 12
+
+// LuckCycle describes the 大运 sequence of a chart.
+type LuckCycle struct {
+	Forward bool
+	// Jie is the 节 the start age was counted to.
+	Jie SolarTermEvent
+	// Start age of the first luck pillar and the instant it begins.
+	StartYears, StartMonths, StartDays int
+	StartTime                          time.Time
+
+	month Pillar
+}
+
+// LuckPillar is one decade of the cycle.
+type LuckPillar struct {
+	Index    int // 0-based
+	Pillar   Pillar
+	Start    time.Time
+	StartAge int // completed years (周岁) at Start
+	// Gregorian years the pillar governs, by the convention that a luck pillar rules
+	// the ten annual pillars from the year it starts in.
+	StartYear, EndYear int
+}
+
+// AnnualPillar is the pillar of a Gregorian year (from its 立春).
+type AnnualPillar struct {
+	Year   int
+	Pillar Pillar
+}
+
+// ComputeLuckCycle derives the luck cycle of a chart born at instant birth, whose
+// start date is reckoned in birth's location.
+func ComputeLuckCycle(birth time.Time, p FourPillars, male bool) LuckCycle {
+	c := LuckCycle{Forward: p.Year.Stem.IsYang() == male, month: p.Month}
+
+	prev, next := JieAround(birth)
+	var d time.Duration
+	if c.Forward {
+		c.Jie, d = next, next.Time.Sub(birth)
+	} else {
+		c.Jie, d = prev, birth.Sub(prev.Time)
+	}
+	if d < 0 {
+		d = 0
+	}
+	minutes := int(d / time.Minute)
+	c.StartYears = minutes / (3 * 24 * 60)
+	c.StartMonths = minutes % (3 * 24 * 60) / (24 * 60 / 4)
+	c.StartDays = minutes % (24 * 60 / 4) / 12 // 1 时辰 (120 min) = 10 days
+	c.StartTime = birth.AddDate(c.StartYears, c.StartMonths, c.StartDays)
+	return c
+}
+
+// Pillar returns the i-th luck pillar (0-based).
+func (c LuckCycle) Pillar(i int) LuckPillar {
+	step := i + 1
+	if !c.Forward {
+		step = -step
+	}
+	start := c.StartTime.AddDate(10*i, 0, 0)
+	return LuckPillar{
+		Index:     i,
+		Pillar:    PillarFromIndex(c.month.Index() + step),
+		Start:     start,
+		StartAge:  c.StartYears + 10*i,
+		StartYear: start.Year(),
+		EndYear:   start.Year() + 9,
+	}
+}
+
+// AnnualPillars lists the annual pillars of the Gregorian years from..to inclusive.
+func AnnualPillars(from, to int) []AnnualPillar {
+	if to < from {
+		return nil
+	}
+	out := make([]AnnualPillar, 0, to-from+1)
+	for y := from; y <= to; y++ {
+		out = append(out, AnnualPillar{Year: y, Pillar: PillarFromIndex(y - 4)})
+	}
+	return out
+}
+
+// BirthYear returns the Gregorian year whose annual pillar is the chart's year
+// pillar: the year of birth, or the one before for a birth ahead of 立春. 虚岁 count
+// from it, 1 in that year.
+func BirthYear(birth time.Time, p FourPillars) int {
+	y := birth.Year()
+	if PillarFromIndex(y-4) != p.Year {
+		y--
+	}
+	return y
+}
+
+// PillarOf returns the luck pillar governing the Gregorian year; false before the
+// cycle starts (起运) or beyond MaxLuckPillars.
+func (c LuckCycle) PillarOf(year int) (LuckPillar, bool) {
+	d := year - c.StartTime.Year()
+	if d < 0 || d/10 >= MaxLuckPillars {
+		return LuckPillar{}, false
+	}
+	return c.Pillar(d / 10), true
+}
@@ -0,0 +1,211 @@
+package bazi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Chinese lunisolar calendar (农历) conversion for lunar years 1900..2100.
+//
+// lunarInfo encodes one lunar year per entry:
+//   - bits 0-3:  leap month number (0 = no leap month)
+//   - bits 4-15: month sizes for months 12..1 (bit 15 = month 1); 1 = 30 days, 0 = 29 days
+//   - bit 16:    size of the leap month (1 = 30 days)
+//
+// The data follows the Purple Mountain Observatory tables (new moon and 中气 in
+// UTC+8); lunar 1900-01-01 is Gregorian 1900-01-31.
+var lunarInfo = [...]uint32{
+	0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900-1909
+	0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977, // 1910-1919
+	0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970, // 1920-1929
+	0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950, // 1930-1939
+	0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557, // 1940-1949
+	0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0, // 1950-1959
+	0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0, // 1960-1969
+	0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6, // 1970-1979
+	0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570, // 1980-1989
+	0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0, // 1990-1999
+	0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5, // 2000-2009
+	0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930, // 2010-2019
+	0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530, // 2020-2029
+	0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45, // 2030-2039
+	0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0, // 2040-2049
+	0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06b20, 0x1a6c4, 0x0aae0, // 2050-2059
+	0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4, // 2060-2069
+	0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0, // 2070-2079
+	0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160, // 2080-2089
+	0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252, // 2090-2099
+	0x0d520, // 2100
+}
+
+const (
+	lunarMinYear = 1900
+	lunarMaxYear = lunarMinYear + len(lunarInfo) - 1
+)
+
+// lunarEpochJDN is the Julian Day Number of lunar 1900-01-01 (Gregorian 1900-01-31).
+var lunarEpochJDN = julianDayNumber(1900, time.January, 31)
+
+// LunarDate is a date in the Chinese lunar calendar.
+type LunarDate struct {
+	Year        int  // lunar year, named after the Gregorian year in which it mostly falls
+	Month       int  // 1..12
+	Day         int  // 1..30
+	IsLeapMonth bool // true for 闰月
+}
+
+var lunarMonthNames = [12]string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+
+// String formats the date the traditional way, e.g. "2023年闰二月初三".
+func (ld LunarDate) String() string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(ld.Year))
+	b.WriteString("年")
+	if ld.IsLeapMonth {
+		b.WriteString("闰")
+	}
+	if ld.Month >= 1 && ld.Month <= 12 {
+		b.WriteString(lunarMonthNames[ld.Month-1])
+	}
+	b.WriteString("月")
+	b.WriteString(lunarDayName(ld.Day))
+	return b.String()
+}
+
+func lunarDayName(d int) string {
+	digits := [11]string{"", "一", "二", "三", "四", "五", "六", "七", "八", "九", "十"}
+	switch {
+	case d < 1 || d > 30:
+		return ""
+	case d <= 10:
+		return "初" + digits[d]
+	case d < 20:
+		return "十" + digits[d-10]
+	case d == 20:
+		return "二十"
+	case d < 30:
+		return "廿" + digits[d-20]
+	default:
+		return "三十"
+	}
+}
+
+// LeapMonth returns the leap month of a lunar year (0 if none).
+func LeapMonth(year int) int {
+	if year < lunarMinYear || year > lunarMaxYear {
+		return 0
+	}
+	return int(lunarInfo[year-lunarMinYear] & 0xf)
+}
+
+// lunarMonthDays returns the length of a month; leap selects the 闰月 after month.
+func lunarMonthDays(year, month int, leap bool) int {
+	info := lunarInfo[year-lunarMinYear]
+	if leap {
+		if info&0x10000 != 0 {
+			return 30
+		}
+		return 29
+	}
+	if info&(0x10000>>uint(month)) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// lunarYearDays returns the number of days in a lunar year.
+func lunarYearDays(year int) int {
+	n := 0
+	for m := 1; m <= 12; m++ {
+		n += lunarMonthDays(year, m, false)
+	}
+	if LeapMonth(year) != 0 {
+		n += lunarMonthDays(year, LeapMonth(year), true)
+	}
+	return n
+}
+
+// SolarToLunar converts the calendar date of t (read from its wall clock) to the lunar calendar.
+func SolarToLunar(t time.Time) (LunarDate, error) {
+	offset := julianDayNumber(t.Year(), t.Month(), t.Day()) - lunarEpochJDN
+	if offset < 0 {
+		return LunarDate{}, fmt.Errorf("date %s out of lunar calendar range", t.Format("2006-01-02"))
+	}
+	for y := lunarMinYear; y <= lunarMaxYear; y++ {
+		n := lunarYearDays(y)
+		if offset >= n {
+			offset -= n
+			continue
+		}
+		leap := LeapMonth(y)
+		for m := 1; m <= 12; m++ {
+			n := lunarMonthDays(y, m, false)
+			if offset < n {
+				return LunarDate{Year: y, Month: m, Day: offset + 1}, nil
+			}
+			offset -= n
+			if m == leap {
+				n := lunarMonthDays(y, m, true)
+				if offset < n {
+					return LunarDate{Year: y, Month: m, Day: offset + 1, IsLeapMonth: true}, nil
+				}
+				offset -= n
+			}
+		}
+	}
+	return LunarDate{}, fmt.Errorf("date %s out of lunar calendar range", t.Format("2006-01-02"))
+}
+
+// LunarToSolar converts a lunar date to its Gregorian date (00:00 UTC of that day).
+func LunarToSolar(ld LunarDate) (time.Time, error) {
+	if ld.Year < lunarMinYear || ld.Year > lunarMaxYear {
+		return time.Time{}, fmt.Errorf("lunar year %d out of range %d..%d", ld.Year, lunarMinYear, lunarMaxYear)
+	}
+	if ld.Month < 1 || ld.Month > 12 {
+		return time.Time{}, fmt.Errorf("invalid lunar month %d", ld.Month)
+	}
+	leap := LeapMonth(ld.Year)
+	if ld.IsLeapMonth && leap != ld.Month {
+		return time.Time{}, fmt.Errorf("lunar year %d has no leap month %d", ld.Year, ld.Month)
+	}
+	if ld.Day < 1 || ld.Day > lunarMonthDays(ld.Year, ld.Month, ld.IsLeapMonth) {
+		return time.Time{}, fmt.Errorf("invalid lunar day %d", ld.Day)
+	}
+
+	offset := 0
+	for y := lunarMinYear; y < ld.Year; y++ {
+		offset += lunarYearDays(y)
+	}
+	for m := 1; m < ld.Month; m++ {
+		offset += lunarMonthDays(ld.Year, m, false)
+		if m == leap {
+			offset += lunarMonthDays(ld.Year, m, true)
+		}
+	}
+	if ld.IsLeapMonth {
+		offset += lunarMonthDays(ld.Year, ld.Month, false)
+	}
+	offset += ld.Day - 1
+
+	days := lunarEpochJDN + offset - 2440588 // days since 1970-01-01
+	return time.Unix(int64(days)*86400, 0).UTC(), nil
+}
+
+// parseLunarDate parses a lunar "YYYY-MM-DD" (month and day are lunar numbers).
+func parseLunarDate(s string, leap bool) (LunarDate, error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 3 {
+		return LunarDate{}, fmt.Errorf("invalid lunar date %q", s)
+	}
+	var nums [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return LunarDate{}, fmt.Errorf("invalid lunar date %q", s)
+		}
+		nums[i] = n
+	}
+	return LunarDate{Year: nums[0], Month: nums[1], Day: nums[2], IsLeapMonth: leap}, nil
+}
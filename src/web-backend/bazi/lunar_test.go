@@ -0,0 +1,99 @@
+package bazi
+
+import (
+	"testing"
+	"time"
+)
+
+// Fixed dates from the Purple Mountain Observatory tables: both ends of the range,
+// Chinese New Years and the leap months of 2020, 2023 and 2033.
+func TestLunarDates(t *testing.T) {
+	for _, tc := range []struct {
+		solar string
+		lunar LunarDate
+	}{
+		{"1900-01-31", LunarDate{Year: 1900, Month: 1, Day: 1}},
+		{"1901-02-18", LunarDate{Year: 1900, Month: 12, Day: 30}},
+		{"1901-02-19", LunarDate{Year: 1901, Month: 1, Day: 1}},
+		{"2020-01-25", LunarDate{Year: 2020, Month: 1, Day: 1}},
+		{"2020-05-22", LunarDate{Year: 2020, Month: 4, Day: 30}},
+		{"2020-05-23", LunarDate{Year: 2020, Month: 4, Day: 1, IsLeapMonth: true}},
+		{"2020-06-20", LunarDate{Year: 2020, Month: 4, Day: 29, IsLeapMonth: true}},
+		{"2020-06-21", LunarDate{Year: 2020, Month: 5, Day: 1}},
+		{"2023-01-22", LunarDate{Year: 2023, Month: 1, Day: 1}},
+		{"2023-03-22", LunarDate{Year: 2023, Month: 2, Day: 1, IsLeapMonth: true}},
+		{"2023-04-20", LunarDate{Year: 2023, Month: 3, Day: 1}},
+		{"2033-01-31", LunarDate{Year: 2033, Month: 1, Day: 1}},
+		{"2033-11-22", LunarDate{Year: 2033, Month: 11, Day: 1}},
+		{"2033-12-22", LunarDate{Year: 2033, Month: 11, Day: 1, IsLeapMonth: true}},
+		{"2034-01-20", LunarDate{Year: 2033, Month: 12, Day: 1}},
+		{"2034-02-19", LunarDate{Year: 2034, Month: 1, Day: 1}},
+		{"2100-02-09", LunarDate{Year: 2100, Month: 1, Day: 1}},
+		{"2101-01-28", LunarDate{Year: 2100, Month: 12, Day: 29}},
+	} {
+		d, err := time.Parse("2006-01-02", tc.solar)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := SolarToLunar(d)
+		if err != nil || got != tc.lunar {
+			t.Errorf("SolarToLunar(%s) = %+v, %v; want %+v", tc.solar, got, err, tc.lunar)
+		}
+		back, err := LunarToSolar(tc.lunar)
+		if err != nil || !back.Equal(d) {
+			t.Errorf("LunarToSolar(%+v) = %s, %v; want %s", tc.lunar, back.Format("2006-01-02"), err, tc.solar)
+		}
+	}
+}
+
+// Leap months around the dates above; 2033 has 闰十一, not the 闰七 of some tables.
+func TestLeapMonth(t *testing.T) {
+	for year, want := range map[int]int{2019: 0, 2020: 4, 2021: 0, 2022: 0, 2023: 2, 2024: 0, 2025: 6, 2033: 11, 2034: 0} {
+		if got := LeapMonth(year); got != want {
+			t.Errorf("LeapMonth(%d) = %d, want %d", year, got, want)
+		}
+	}
+	if _, err := LunarToSolar(LunarDate{Year: 2033, Month: 7, Day: 1, IsLeapMonth: true}); err == nil {
+		t.Error("LunarToSolar accepted 闰七月 of 2033")
+	}
+}
+
+// Every Chinese New Year and the day before it round-trip, and the two are one
+// day apart across the year boundary.
+func TestLunarNewYearRoundTrip(t *testing.T) {
+	for y := lunarMinYear + 1; y <= lunarMaxYear; y++ {
+		ny, err := LunarToSolar(LunarDate{Year: y, Month: 1, Day: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		eve := ny.AddDate(0, 0, -1)
+		for _, d := range []time.Time{eve, ny} {
+			ld, err := SolarToLunar(d)
+			if err != nil {
+				t.Fatalf("SolarToLunar(%s): %v", d.Format("2006-01-02"), err)
+			}
+			back, err := LunarToSolar(ld)
+			if err != nil || !back.Equal(d) {
+				t.Errorf("%s → %+v → %s, %v", d.Format("2006-01-02"), ld, back.Format("2006-01-02"), err)
+			}
+		}
+		if ld, _ := SolarToLunar(eve); ld.Year != y-1 || ld.Month != 12 || ld.Day != lunarMonthDays(y-1, 12, ld.IsLeapMonth) {
+			t.Errorf("%d: eve %s is %+v, want the last day of 腊月 %d", y, eve.Format("2006-01-02"), ld, y-1)
+		}
+	}
+}
+
+// Dates outside 1900..2100 are rejected rather than wrapped.
+func TestLunarRangeEnds(t *testing.T) {
+	for _, s := range []string{"1900-01-30", "2101-01-29"} {
+		d, _ := time.Parse("2006-01-02", s)
+		if ld, err := SolarToLunar(d); err == nil {
+			t.Errorf("SolarToLunar(%s) = %+v, want an error", s, ld)
+		}
+	}
+	for _, ld := range []LunarDate{{Year: 1899, Month: 12, Day: 1}, {Year: 2101, Month: 1, Day: 1}} {
+		if d, err := LunarToSolar(ld); err == nil {
+			t.Errorf("LunarToSolar(%+v) = %s, want an error", ld, d.Format("2006-01-02"))
+		}
+	}
+}
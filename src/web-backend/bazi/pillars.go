@@ -0,0 +1,133 @@
+package bazi
+
+import (
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FourPillars is the eight-character chart (四柱八字): year, month, day and hour pillars.
+type FourPillars struct {
+	Year  Pillar
+	Month Pillar
+	Day   Pillar
+	Hour  Pillar
+}
+
+func (fp FourPillars) String() string {
+	return fp.Year.String() + " " + fp.Month.String() + " " + fp.Day.String() + " " + fp.Hour.String()
+}
+
+// DayBoundary is the convention for the hour 23:00–24:00 (子时 straddles midnight).
+type DayBoundary int
+
+const (
+	// ZiInitial: the day pillar changes at 23:00 (子初换日), together with the hour.
+	ZiInitial DayBoundary = iota
+	// SplitZi: the day pillar changes at 00:00; 23:00–24:00 is 晚子时 (夜子时), which
+	// keeps the current day pillar but takes its hour stem from the next day, while
+	// 00:00–01:00 is 早子时.
+	SplitZi
+	// Midnight: the day pillar changes at 00:00 and 23:00–24:00 is the current day's
+	// 子时, hour stem included.
+	Midnight
+)
+
+var dayBoundaryNames = [3]string{"zi_initial", "split_zi", "midnight"}
+
+var dayBoundaryLabels = [3]string{"子初换日（23:00换日）", "早晚子时（00:00换日，晚子时按次日起时干）", "子正换日（00:00换日，按当日起时干）"}
+
+func (d DayBoundary) String() string { return dayBoundaryNames[d] }
+
+// Label describes the convention in Chinese.
+func (d DayBoundary) Label() string { return dayBoundaryLabels[d] }
+
+// ParseDayBoundary parses a name returned by DayBoundary.String.
+func ParseDayBoundary(s string) (DayBoundary, bool) {
+	for i, n := range dayBoundaryNames {
+		if n == strings.ToLower(strings.TrimSpace(s)) {
+			return DayBoundary(i), true
+		}
+	}
+	return ZiInitial, false
+}
+
+// DefaultDayBoundary is the server-wide convention, from BAZI_DAY_BOUNDARY
+// ("zi_initial", "split_zi" or "midnight"; default "zi_initial").
+var DefaultDayBoundary = sync.OnceValue(func() DayBoundary {
+	v := os.Getenv("BAZI_DAY_BOUNDARY")
+	d, ok := ParseDayBoundary(v)
+	if !ok && strings.TrimSpace(v) != "" {
+		log.Printf("unknown BAZI_DAY_BOUNDARY %q, using %s", v, d)
+	}
+	return d
+})
+
+// ComputePillars derives the four pillars of a birth.
+//
+//   - instant is the real moment of birth. Year and month pillars follow the solar
+//     terms, which are absolute instants: the year changes at 立春 (sun at 315°) and
+//     the month at each 节 (every 30° from 立春).
+//   - solarTime is the local true solar time, read through its wall clock
+//     (Year/Month/Day/Hour). Day and hour pillars are taken from it.
+//   - db says how the hour 23:00–24:00 splits the day.
+func ComputePillars(instant, solarTime time.Time, db DayBoundary) FourPillars {
+	lambda := sunApparentLongitude(julianEphemerisDay(instant))
+
+	// Bazi year: Jan/Feb births before 立春 still belong to the previous year.
+	year := solarTime.Year()
+	if solarTime.Month() <= time.February && lambda >= 270 && lambda < 315 {
+		year--
+	}
+	yearP := PillarFromIndex(year - 4) // 公元4年 = 甲子
+
+	// Month index from 寅月 (0) to 丑月 (11).
+	m := int(modf(lambda-315, 360) / 30)
+	monthP := monthPillar(yearP.Stem, m)
+	dayP, hourP := dayHourPillars(solarTime, db)
+
+	return FourPillars{Year: yearP, Month: monthP, Day: dayP, Hour: hourP}
+}
+
+// monthPillar returns the m-th month (0 = 寅月 ... 11 = 丑月) of a year with the given stem.
+func monthPillar(yearStem Stem, m int) Pillar {
+	return Pillar{
+		// 甲己之年丙作首, 乙庚之岁戊为头, ...
+		Stem:   Stem(mod(int(yearStem)%5*2+2+m, 10)),
+		Branch: Branch(mod(m+2, 12)),
+	}
+}
+
+// dayHourPillars returns the day and hour pillars read from a solar time's wall clock.
+func dayHourPillars(solarTime time.Time, db DayBoundary) (dayP, hourP Pillar) {
+	h := solarTime.Hour()
+	jdn := julianDayNumber(solarTime.Year(), solarTime.Month(), solarTime.Day())
+	dayP = PillarFromIndex(jdn + 49)
+	// The day whose stem the hour stem is counted from.
+	hourDay := dayP
+	if h >= 23 {
+		switch db {
+		case ZiInitial:
+			dayP = PillarFromIndex(jdn + 50)
+			hourDay = dayP
+		case SplitZi:
+			hourDay = PillarFromIndex(jdn + 50)
+		}
+	}
+
+	hb := Branch(mod((h+1)/2, 12))
+	hourP = Pillar{
+		// 甲己还加甲, 乙庚丙作初, ...
+		Stem:   Stem(mod(int(hourDay.Stem)%5*2+int(hb), 10)),
+		Branch: hb,
+	}
+	return dayP, hourP
+}
+
+// julianDayNumber returns the integer Julian Day Number of a Gregorian calendar date.
+func julianDayNumber(y int, m time.Month, d int) int {
+	days := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() / 86400
+	return int(days) + 2440588
+}
@@ -4,73 +4,125 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"time"
+	"math"
+	"strings"
 
 	pb "llyb-backend/proto"
 )
 
 // Reasoning is the backend handler for the "基础推理" page.
 //
-// For now it just echoes the request payload back to the caller so the frontend
-// can validate wiring without depending on real bazi/solar-time logic yet.
+// It echoes the request payload, corrects the birth time to local true solar time
+// and derives the four pillars (四柱) from it. With an uncertain birth time it lists
+// the candidate hour pillars instead and withholds the analyses that depend on them.
 func Reasoning(ctx context.Context, req *pb.ReasoningRequest) (*pb.ReasoningResponse, error) {
-	if req == nil {
+	b, err := resolveBirth(ctx, req)
+	if err != nil {
 		return &pb.ReasoningResponse{
 			Code:    1002,
-			Message: "参数不合法",
+			Message: err.Error(),
 		}, nil
 	}
+	v, err := newChartView(b)
+	if err != nil {
+		return nil, err
+	}
 
-	trueSolarTime := ""
-	province := req.GetProvince()
-	city := req.GetCity()
-
-	lonDeg, lonErr := ResolveCityLongitude(ctx, province, city)
-	lonOK := lonErr == nil
-	lonSource := "amap"
+	// If the gazetteer and the geocoders all fail, surface the error.
 	var trueSolarTimeErr string
-	if lonOK {
-		if s, err := TrueSolarTimeFromBeijing(req.GetSolarDate(), req.GetBirthTime(), lonDeg); err == nil {
-			trueSolarTime = s
-		} else {
-			trueSolarTimeErr = err.Error()
-		}
-	} else {
-		// If AMap fails, fall back to Beijing time and surface the AMap error.
-		bt, err := time.ParseInLocation(
-			"2006-01-02 15:04",
-			req.GetSolarDate()+" "+req.GetBirthTime(),
-			time.FixedZone("CST", 8*3600),
-		)
-		if err == nil {
-			trueSolarTime = bt.Format("2006/01/02 15:04")
+	if !b.lonOK() {
+		trueSolarTimeErr = "geo_failed: " + b.lonErr.Error()
+	}
+
+	// Echo the birth date in both calendars (lunar is nil outside 1900..2100).
+	var lunarEcho any
+	ld, lunarErr := SolarToLunar(b.civil)
+	if lunarErr == nil {
+		lunarEcho = map[string]any{
+			"year":          ld.Year,
+			"month":         ld.Month,
+			"day":           ld.Day,
+			"is_leap_month": ld.IsLeapMonth,
+			"text":          ld.String(),
 		}
-		trueSolarTimeErr = "amap_failed: " + lonErr.Error()
 	}
 
 	echo := map[string]any{
-		"gender":     req.GetGender().String(),
-		"solar_date": req.GetSolarDate(),
-		"birth_time": req.GetBirthTime(),
-		"province":   province,
-		"city":       city,
+		"gender":         req.GetGender().String(),
+		"country":        b.country,
+		"time_zone":      b.zoneName(),    // IANA name; empty when China's historical rules applied
+		"input_calendar": b.inputCalendar, // "solar" | "lunar"
+		"solar_date":     b.solarDate,
+		"lunar_date":     lunarEcho,
+		"birth_time":     b.birthTime,
+		"province":       b.province,
+		"city":           b.city,
+		"district":       b.district,
 		"longitude_deg": func() any {
-			if lonOK {
-				return lonDeg
+			if b.lonOK() {
+				return b.geo.Longitude
+			}
+			return nil
+		}(),
+		"longitude_source": func() any { // "input" | "gazetteer" | geocoder name
+			if b.lonOK() {
+				return b.geo.Provider
 			}
 			return nil
 		}(),
-		"longitude_source": func() any {
-			if lonOK {
-				return lonSource
+		"longitude_level": func() any { // "input" | "district" | "city"
+			if b.lonOK() {
+				return b.geo.Level
 			}
 			return nil
 		}(),
-		"true_solar_time":     trueSolarTime, // "YYYY/MM/DD HH:mm" (empty if longitude not resolved)
+		"civil_time_rule": map[string]any{
+			"name":       b.civilRule.Name,
+			"utc_offset": b.civilRule.OffsetString(),
+			"dst":        b.civilRule.DST,
+		},
+		"true_solar_time":     b.trueSolar.Format("2006/01/02 15:04"), // "YYYY/MM/DD HH:mm" (zone standard time if longitude not resolved)
 		"true_solar_time_err": trueSolarTimeErr,
+		"time_mode":           b.timeMode, // "exact" | "range" | "unknown"
+		"birth_time_range":    nil,
+		"hour_candidates":     nil,
+		"hour_dependent":      v.dependent, // keys withheld or reduced because the birth time is uncertain
+		"pillars":             pillarsEcho(v.placed, v.known),
+		"bazi":                baziText(v.placed, v.known), // "年柱 月柱 日柱 时柱"; "？？" for uncertain pillars
+		"day_boundary": map[string]any{ // 子时 convention the chart was computed with
+			"name":    b.dayBoundary.String(),
+			"label":   b.dayBoundary.Label(),
+			"late_zi": b.trueSolar.Hour() == 23, // birth falls in 23:00–24:00, where conventions differ
+		},
+		"five_elements": nil,
+		"day_master":    nil,
+		"ten_gods":      nil,
+		"interactions":  nil,
+		"annotations":   nil,
+		"void_branches": nil,
+	}
+	if v.hasElements {
+		echo["five_elements"], echo["day_master"] = elementsEcho(v.elements), dayMasterEcho(v.elements)
+	}
+	if v.hasNatal {
+		echo["ten_gods"] = tenGodsEcho(v.gods)
+		echo["interactions"] = interactionsEcho(v.interactions)
+		echo["annotations"] = annotationsEcho(v.annotations)
+		echo["void_branches"] = map[string]any{ // 空亡 by the day and by the year pillar's decade
+			"day":  voidText(VoidBranches(v.pillars.Day)),
+			"year": voidText(VoidBranches(v.pillars.Year)),
+		}
+	}
+	if b.timeMode != "exact" {
+		echo["birth_time_range"] = map[string]any{
+			"from": clockString(b.timeFrom),
+			"to":   clockString(b.timeTo),
+		}
+		echo["hour_candidates"] = hourCandidatesEcho(b.candidates)
+		echo["true_solar_time"] = nil
 	}
 
-	b, err := json.Marshal(echo)
+	out, err := json.Marshal(echo)
 	if err != nil {
 		return &pb.ReasoningResponse{
 			Code:    1003,
@@ -78,9 +130,262 @@ func Reasoning(ctx context.Context, req *pb.ReasoningRequest) (*pb.ReasoningResp
 		}, nil
 	}
 
-	return &pb.ReasoningResponse{
+	resp := &pb.ReasoningResponse{
 		Code:       0,
 		Message:    "ok",
-		ResultJson: string(b),
-	}, nil
+		ResultJson: string(out),
+		Birth:      birthInfo(req, b),
+		SolarTime:  solarTimeInfo(b, trueSolarTimeErr),
+		Chart:      chartInfo(b, v),
+	}
+	if lunarErr == nil {
+		resp.Birth.LunarDate = &pb.LunarDateInfo{
+			Year:        int32(ld.Year),
+			Month:       int32(ld.Month),
+			Day:         int32(ld.Day),
+			IsLeapMonth: ld.IsLeapMonth,
+			Text:        ld.String(),
+		}
+	}
+	if v.hasElements {
+		resp.FiveElements = elementAnalysisInfo(v.elements)
+		resp.DayMaster = dayMasterInfo(v.elements)
+	}
+	for _, c := range b.candidates {
+		resp.HourCandidates = append(resp.HourCandidates, hourCandidateInfo(c))
+	}
+	resp.HourDependent = v.dependent
+	if req.GetTrace() {
+		resp.Trace = traceInfo(buildTrace(b))
+	}
+	return resp, nil
+}
+
+// chartView is what Reasoning can say about a chart. With an uncertain birth time
+// only what the candidate charts agree on is kept: element balance and strength
+// weigh all four pillars, so they need every pillar determined; the rest is shown
+// without the hour unless a natal pillar is uncertain too.
+type chartView struct {
+	pillars FourPillars
+	placed  []PlacedPillar
+	known   [4]bool
+
+	hasElements bool
+	elements    ElementAnalysis
+
+	hasNatal     bool
+	gods         []PillarGods
+	interactions []Interaction
+	annotations  []PillarAnnotation
+
+	// dependent lists the result_json keys withheld or reduced for the uncertainty.
+	dependent []string
 }
+
+func newChartView(b *birth) (chartView, error) {
+	v := chartView{pillars: b.pillars, known: [4]bool{true, true, true, true}, dependent: []string{}}
+	if len(b.candidates) > 0 {
+		v.pillars, v.known = commonPillars(b.candidates)
+	}
+	v.placed = v.pillars.Placed()
+	annotations, err := AnnotatePillars(v.pillars)
+	if err != nil {
+		return v, err
+	}
+	gods := ChartTenGods(v.pillars)
+
+	n := 4
+	if !v.known[3] {
+		n = 3
+	}
+	v.hasElements = v.known == [4]bool{true, true, true, true}
+	v.hasNatal = v.known[0] && v.known[1] && v.known[2]
+	if v.hasElements {
+		v.elements = AnalyzeElements(v.pillars)
+	}
+	if v.hasNatal {
+		v.gods, v.annotations = gods[:n], annotations[:n]
+		v.interactions = DetectInteractions(v.placed[:n])
+	}
+
+	if b.timeMode == "exact" {
+		return v, nil
+	}
+	v.dependent = append(v.dependent, "true_solar_time")
+	if v.hasElements {
+		return v, nil
+	}
+	for i, ok := range v.known {
+		if !ok {
+			v.dependent = append(v.dependent, "pillars."+pillarPositions[i])
+		}
+	}
+	v.dependent = append(v.dependent, "five_elements", "day_master", "ten_gods", "interactions", "annotations")
+	if !v.hasNatal {
+		v.dependent = append(v.dependent, "void_branches")
+	}
+	return v, nil
+}
+
+// elementsEcho renders per-element scores keyed by element name (木火土金水).
+func elementsEcho(a ElementAnalysis) map[string]any {
+	scores := make(map[string]any, 5)
+	percent := make(map[string]any, 5)
+	counts := make(map[string]any, 5)
+	states := make(map[string]any, 5)
+	for e := Wood; e <= Water; e++ {
+		scores[e.String()] = math.Round(a.Scores[e]*100) / 100
+		percent[e.String()] = math.Round(a.Percent[e]*10) / 10
+		counts[e.String()] = a.Counts[e]
+		states[e.String()] = a.States[e].String()
+	}
+	return map[string]any{
+		"scores":  scores,
+		"percent": percent,
+		"counts":  counts, // visible characters (4 stems + 4 branches)
+		"missing": elementList(a.Missing),
+		"season":  a.Season.String(), // element in command (当令)
+		"states":  states,            // 旺相休囚死
+	}
+}
+
+// dayMasterEcho renders the day master's strength judgement.
+func dayMasterEcho(a ElementAnalysis) map[string]any {
+	return map[string]any{
+		"stem":          a.DayMaster.String(),
+		"element":       a.DayMaster.Element().String(),
+		"strength":      a.Category.String(), // 极弱 | 偏弱 | 中和 | 偏强 | 极强
+		"support_ratio": math.Round(a.Ratio*1000) / 1000,
+		"de_ling":       a.DeLing,
+		"de_di":         a.DeDi,
+		"de_shi":        a.DeShi,
+		"favourable":    elementList(a.Favourable),
+		"unfavourable":  elementList(a.Unfavourable),
+	}
+}
+
+func elementList(es []Element) []string {
+	out := make([]string, 0, len(es))
+	for _, e := range es {
+		out = append(out, e.String())
+	}
+	return out
+}
+
+var pillarPositions = [4]string{"year", "month", "day", "hour"}
+
+// pillarsEcho renders the natal pillars by position; uncertain ones are null.
+func pillarsEcho(placed []PlacedPillar, known [4]bool) map[string]any {
+	out := make(map[string]any, 4)
+	for i, pp := range placed {
+		out[pillarPositions[i]] = nil
+		if known[i] {
+			out[pillarPositions[i]] = pp.Pillar.String()
+		}
+	}
+	return out
+}
+
+func baziText(placed []PlacedPillar, known [4]bool) string {
+	parts := make([]string, 0, 4)
+	for i, pp := range placed {
+		if known[i] {
+			parts = append(parts, pp.Pillar.String())
+		} else {
+			parts = append(parts, "？？")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// hourCandidatesEcho renders each candidate chart with the birth-time window it
+// covers, and the day master's strength under it.
+func hourCandidatesEcho(cs []HourCandidate) []any {
+	out := make([]any, 0, len(cs))
+	for _, c := range cs {
+		a := AnalyzeElements(c.Pillars)
+		out = append(out, map[string]any{
+			"bazi":            c.Pillars.String(),
+			"hour_pillar":     c.Pillars.Hour.String(),
+			"civil_from":      c.CivilFrom.Format("15:04"), // clock time, as entered
+			"civil_to":        c.CivilTo.Format("15:04"),
+			"true_solar_from": c.SolarFrom.Format("2006/01/02 15:04"),
+			"true_solar_to":   c.SolarTo.Format("2006/01/02 15:04"),
+			"strength":        a.Category.String(),
+			"favourable":      elementList(a.Favourable),
+		})
+	}
+	return out
+}
+
+// tenGodsEcho renders the Ten Gods of each pillar, year first.
+func tenGodsEcho(gods []PillarGods) []any {
+	out := make([]any, 0, 4)
+	for i, pg := range gods {
+		stemGod := pg.StemGod.String()
+		if pg.IsDayMaster {
+			stemGod = "日主"
+		}
+		hidden := make([]any, 0, len(pg.Hidden))
+		for _, h := range pg.Hidden {
+			hidden = append(hidden, map[string]any{
+				"stem":    h.Stem.String(),
+				"element": h.Stem.Element().String(),
+				"qi":      h.Qi.String(), // 本气 | 中气 | 余气
+				"god":     h.God.String(),
+			})
+		}
+		out = append(out, map[string]any{
+			"position": pillarPositions[i],
+			"pillar":   pg.Pillar.String(),
+			"stem_god": stemGod,
+			"hidden":   hidden,
+		})
+	}
+	return out
+}
+
+// interactionsEcho renders relations between the natal pillars.
+func interactionsEcho(ins []Interaction) []any {
+	out := make([]any, 0, len(ins))
+	for _, in := range ins {
+		positions := make([]string, 0, len(in.Positions))
+		for _, p := range in.Positions {
+			positions = append(positions, p.String())
+		}
+		m := map[string]any{
+			"kind":      in.Kind.String(),
+			"name":      in.Name,
+			"positions": positions,
+		}
+		if in.HasElement {
+			m["element"] = in.Element.String()
+		}
+		out = append(out, m)
+	}
+	return out
+}
+
+// annotationsEcho renders 纳音, 十二长生, 空亡 and 神煞 per pillar.
+func annotationsEcho(as []PillarAnnotation) []any {
+	out := make([]any, 0, len(as))
+	for _, a := range as {
+		stars := make([]any, 0, len(a.Stars))
+		for _, st := range a.Stars {
+			stars = append(stars, map[string]any{"name": st.Name, "rule": st.Rule})
+		}
+		out = append(out, map[string]any{
+			"position":   a.Position.String(),
+			"pillar":     a.Pillar.String(),
+			"na_yin":     a.NaYin,
+			"stage":      a.Stage.String(),     // day master at this branch (星运)
+			"self_stage": a.SelfStage.String(), // the pillar's own stem (自坐)
+			"void":       a.Void,
+			"year_void":  a.YearVoid,
+			"stars":      stars,
+		})
+	}
+	return out
+}
+
+func voidText(v [2]Branch) string { return v[0].String() + v[1].String() }
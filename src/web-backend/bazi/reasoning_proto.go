@@ -0,0 +1,136 @@
+package bazi
+
+import (
+	"math"
+
+	pb "llyb-backend/proto"
+)
+
+// Typed counterparts of the result_json echo of Reasoning.
+
+func birthInfo(req *pb.ReasoningRequest, b *birth) *pb.BirthInfo {
+	info := &pb.BirthInfo{
+		Gender:        req.GetGender(),
+		Country:       b.country,
+		TimeZone:      b.zoneName(),
+		InputCalendar: b.inputCalendar,
+		SolarDate:     b.solarDate,
+		BirthTime:     b.birthTime,
+		Province:      b.province,
+		City:          b.city,
+		District:      b.district,
+		TimeMode:      b.timeMode,
+	}
+	if b.timeMode != "exact" {
+		info.BirthTimeFrom, info.BirthTimeTo = clockString(b.timeFrom), clockString(b.timeTo)
+	}
+	return info
+}
+
+func solarTimeInfo(b *birth, geoErr string) *pb.SolarTimeInfo {
+	info := &pb.SolarTimeInfo{
+		CivilRule: b.civilRule.Name,
+		UtcOffset: b.civilRule.OffsetString(),
+		Dst:       b.civilRule.DST,
+		Error:     geoErr,
+	}
+	if b.lonOK() {
+		lon := b.geo.Longitude
+		info.Longitude, info.LongitudeSource, info.LongitudeLevel = &lon, b.geo.Provider, b.geo.Level
+	}
+	if b.timeMode == "exact" {
+		info.TrueSolarTime = b.trueSolar.Format("2006/01/02 15:04")
+	}
+	return info
+}
+
+func chartInfo(b *birth, v chartView) *pb.Chart {
+	c := &pb.Chart{
+		Bazi:             baziText(v.placed, v.known),
+		DayBoundary:      b.dayBoundary.String(),
+		DayBoundaryLabel: b.dayBoundary.Label(),
+		LateZi:           b.trueSolar.Hour() == 23,
+	}
+	for i, pp := range v.placed {
+		p := &pb.Pillar{Position: pp.Position.String(), Uncertain: !v.known[i]}
+		if v.known[i] {
+			p.Text = pp.Pillar.String()
+			p.Stem, p.Branch = pp.Pillar.Stem.String(), pp.Pillar.Branch.String()
+			p.StemElement, p.BranchElement = pp.Pillar.Stem.Element().String(), pp.Pillar.Branch.Element().String()
+		}
+		if i < len(v.gods) {
+			g := v.gods[i]
+			p.StemGod = g.StemGod.String()
+			if g.IsDayMaster {
+				p.StemGod = "日主"
+			}
+			for _, h := range g.Hidden {
+				p.Hidden = append(p.Hidden, &pb.HiddenStemInfo{
+					Stem:    h.Stem.String(),
+					Element: h.Stem.Element().String(),
+					Qi:      h.Qi.String(),
+					God:     h.God.String(),
+				})
+			}
+		}
+		if i < len(v.annotations) {
+			a := v.annotations[i]
+			p.NaYin, p.Stage, p.SelfStage = a.NaYin, a.Stage.String(), a.SelfStage.String()
+			p.Void, p.YearVoid = a.Void, a.YearVoid
+			for _, st := range a.Stars {
+				p.Stars = append(p.Stars, &pb.StarInfo{Name: st.Name, Rule: st.Rule})
+			}
+		}
+		c.Pillars = append(c.Pillars, p)
+	}
+	if v.hasNatal {
+		for _, in := range v.interactions {
+			c.Interactions = append(c.Interactions, interactionInfo(in))
+		}
+		c.DayVoid = voidText(VoidBranches(v.pillars.Day))
+		c.YearVoid = voidText(VoidBranches(v.pillars.Year))
+	}
+	return c
+}
+
+func elementAnalysisInfo(a ElementAnalysis) *pb.ElementAnalysisInfo {
+	info := &pb.ElementAnalysisInfo{Missing: elementList(a.Missing), Season: a.Season.String()}
+	for e := Wood; e <= Water; e++ {
+		info.Elements = append(info.Elements, &pb.ElementScores{
+			Element: e.String(),
+			Score:   math.Round(a.Scores[e]*100) / 100,
+			Percent: math.Round(a.Percent[e]*10) / 10,
+			Count:   int32(a.Counts[e]),
+			State:   a.States[e].String(),
+		})
+	}
+	return info
+}
+
+func dayMasterInfo(a ElementAnalysis) *pb.DayMasterInfo {
+	return &pb.DayMasterInfo{
+		Stem:         a.DayMaster.String(),
+		Element:      a.DayMaster.Element().String(),
+		Strength:     a.Category.String(),
+		SupportRatio: math.Round(a.Ratio*1000) / 1000,
+		DeLing:       a.DeLing,
+		DeDi:         a.DeDi,
+		DeShi:        a.DeShi,
+		Favourable:   elementList(a.Favourable),
+		Unfavourable: elementList(a.Unfavourable),
+	}
+}
+
+func hourCandidateInfo(c HourCandidate) *pb.HourCandidateInfo {
+	a := AnalyzeElements(c.Pillars)
+	return &pb.HourCandidateInfo{
+		Bazi:          c.Pillars.String(),
+		HourPillar:    c.Pillars.Hour.String(),
+		CivilFrom:     c.CivilFrom.Format("15:04"),
+		CivilTo:       c.CivilTo.Format("15:04"),
+		TrueSolarFrom: c.SolarFrom.Format("2006/01/02 15:04"),
+		TrueSolarTo:   c.SolarTo.Format("2006/01/02 15:04"),
+		Strength:      a.Category.String(),
+		Favourable:    elementList(a.Favourable),
+	}
+}
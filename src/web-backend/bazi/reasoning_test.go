@@ -0,0 +1,42 @@
+package bazi
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	pb "llyb-backend/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// A birth time range across 立春 leaves the year and month pillars undetermined, so
+// the element analysis must be withheld as it is for an uncertain hour.
+func TestReasoningRangeAcrossLichun(t *testing.T) {
+	resp, err := Reasoning(context.Background(), &pb.ReasoningRequest{
+		Gender:        pb.Gender_GENDER_FEMALE,
+		SolarDate:     "2024-02-04",
+		BirthTimeFrom: "16:00",
+		BirthTimeTo:   "16:50",
+		Province:      "北京市",
+		City:          "北京市",
+		Longitude:     proto.Float64(116.4),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetCode() != 0 {
+		t.Fatalf("code %d: %s", resp.GetCode(), resp.GetMessage())
+	}
+	if n := len(resp.GetHourCandidates()); n < 2 {
+		t.Fatalf("got %d candidates, want the range split at 立春", n)
+	}
+	if resp.GetFiveElements() != nil || resp.GetDayMaster() != nil {
+		t.Error("five_elements/day_master returned with undetermined year and month pillars")
+	}
+	for _, key := range []string{"pillars.year", "pillars.month", "five_elements", "day_master"} {
+		if !slices.Contains(resp.GetHourDependent(), key) {
+			t.Errorf("hour_dependent = %v, missing %s", resp.GetHourDependent(), key)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package bazi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// Reverse lookup (反推): the birth datetimes whose chart has the given pillars.
+//
+// The search walks the solar months (节 to 节) of the bazi years in range, skipping
+// years and months whose pillars cannot match, then the days and double hours (时辰)
+// of each remaining month on the solar-time clock. A pillar repeats every 60 years,
+// months or days, so the cost is bounded by the year span and the result limit.
+
+const (
+	maxReverseLookupYears   = 300
+	defaultReverseLookupMax = 100
+	maxReverseLookupMax     = 500
+)
+
+// PillarQuery selects charts by any subset of their pillars; nil matches anything.
+type PillarQuery struct {
+	Year, Month, Day, Hour *Pillar
+}
+
+func (q PillarQuery) empty() bool {
+	return q.Year == nil && q.Month == nil && q.Day == nil && q.Hour == nil
+}
+
+func pillarMatches(want *Pillar, got Pillar) bool { return want == nil || *want == got }
+
+// DatetimeWindow is a span of birth instants [From, To) sharing one chart, with the
+// same span on the solar-time clock the day and hour pillars were read from.
+type DatetimeWindow struct {
+	Pillars            FourPillars
+	From, To           time.Time
+	SolarFrom, SolarTo time.Time
+}
+
+// solarClock maps instants to the solar-time clock: true solar time at a longitude,
+// or Beijing time when no longitude is given. Clock readings are represented as
+// times in UTC whose wall clock is the reading.
+type solarClock struct {
+	longitude    float64
+	hasLongitude bool
+}
+
+func (c solarClock) of(t time.Time) time.Time {
+	if c.hasLongitude {
+		return wallAsUTC(trueSolarTime(t, c.longitude))
+	}
+	return wallAsUTC(t.In(beijing))
+}
+
+// instant inverts of. The true solar offset changes by well under a second per
+// minute, so two fixed-point steps are exact to the second.
+func (c solarClock) instant(s time.Time) time.Time {
+	if !c.hasLongitude {
+		return time.Date(s.Year(), s.Month(), s.Day(), s.Hour(), s.Minute(), s.Second(), 0, beijing)
+	}
+	t := s.Add(-time.Duration(4 * c.longitude * float64(time.Minute)))
+	for i := 0; i < 2; i++ {
+		t = t.Add(s.Sub(c.of(t)))
+	}
+	return t.Round(time.Second)
+}
+
+func wallAsUTC(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// shichenStarts are the clock hours at which the hour pillar (or, at 23:00, possibly
+// the day pillar) changes.
+var shichenStarts = [13]int{0, 1, 3, 5, 7, 9, 11, 13, 15, 17, 19, 21, 23}
+
+// FindBirthWindows lists the windows of Gregorian years fromYear..toYear (Beijing
+// time) whose chart matches q, at most limit of them; truncated reports whether more
+// exist.
+func FindBirthWindows(ctx context.Context, q PillarQuery, fromYear, toYear int, clock solarClock, db DayBoundary, limit int) (out []DatetimeWindow, truncated bool, err error) {
+	lower := time.Date(fromYear, time.January, 1, 0, 0, 0, 0, beijing)
+	upper := time.Date(toYear+1, time.January, 1, 0, 0, 0, 0, beijing)
+
+	// Bazi year y runs from 立春 of y to 立春 of y+1, so year fromYear-1 reaches into
+	// the range.
+	for y := fromYear - 1; y <= toYear; y++ {
+		if err := ctx.Err(); err != nil {
+			return nil, false, err
+		}
+		yearP := PillarFromIndex(y - 4)
+		if !pillarMatches(q.Year, yearP) {
+			continue
+		}
+		for m := 0; m < 12; m++ {
+			monthP := monthPillar(yearP.Stem, m)
+			if !pillarMatches(q.Month, monthP) {
+				continue
+			}
+			start, end := jieOfMonth(y, m), jieOfMonth(y, m+1)
+			start, end = maxTime(start, lower), minTime(end, upper)
+			if !start.Before(end) {
+				continue
+			}
+			ws := monthWindows(q, FourPillars{Year: yearP, Month: monthP}, start, end, clock, db)
+			for _, w := range ws {
+				if len(out) == limit {
+					return out, true, nil
+				}
+				out = append(out, w)
+			}
+		}
+	}
+	return out, false, nil
+}
+
+// jieOfMonth returns the 节 starting month m (0 = 寅月; 12 is the next year's 寅月)
+// of bazi year y.
+func jieOfMonth(y, m int) time.Time {
+	term := 2 + 2*m // 立春 = 2, 惊蛰 = 4, ..., 大雪 = 22, then 小寒 and 立春 of y+1
+	if term >= 24 {
+		return SolarTerm(term - 24).Time(y + 1)
+	}
+	return SolarTerm(term).Time(y)
+}
+
+// monthWindows scans the double hours of the instants [start, end), all within one
+// solar month, for day and hour pillars matching q.
+func monthWindows(q PillarQuery, ym FourPillars, start, end time.Time, clock solarClock, db DayBoundary) []DatetimeWindow {
+	var out []DatetimeWindow
+	ss, se := clock.of(start), clock.of(end)
+	for day := ss.Truncate(24 * time.Hour); day.Before(se); day = day.AddDate(0, 0, 1) {
+		for i, h := range shichenStarts {
+			from := day.Add(time.Duration(h) * time.Hour)
+			to := day.Add(24 * time.Hour)
+			if i+1 < len(shichenStarts) {
+				to = day.Add(time.Duration(shichenStarts[i+1]) * time.Hour)
+			}
+			from, to = maxTime(from, ss), minTime(to, se)
+			if !from.Before(to) {
+				continue
+			}
+			fp := ym
+			fp.Day, fp.Hour = dayHourPillars(from, db)
+			if !pillarMatches(q.Day, fp.Day) || !pillarMatches(q.Hour, fp.Hour) {
+				continue
+			}
+			// Adjacent double hours with the same chart (早子时 after a 23:00 day change)
+			// form one window.
+			if n := len(out); n > 0 && out[n-1].Pillars == fp && out[n-1].SolarTo.Equal(from) {
+				out[n-1].SolarTo = to
+				continue
+			}
+			out = append(out, DatetimeWindow{Pillars: fp, SolarFrom: from, SolarTo: to})
+		}
+	}
+	// Window edges on the month boundary are the 节 instants themselves.
+	for i := range out {
+		out[i].From, out[i].To = clock.instant(out[i].SolarFrom), clock.instant(out[i].SolarTo)
+		if out[i].SolarFrom.Equal(ss) {
+			out[i].From = start
+		}
+		if out[i].SolarTo.Equal(se) {
+			out[i].To = end
+		}
+	}
+	return out
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+// ReverseLookup is the backend handler for "/bazi/reverse-lookup": the birth
+// datetime windows whose chart has the given pillars.
+func ReverseLookup(ctx context.Context, req *pb.ReverseLookupRequest) (*pb.ReverseLookupResponse, error) {
+	var q PillarQuery
+	for _, f := range []struct {
+		text  string
+		label string
+		dst   **Pillar
+	}{
+		{req.GetYearPillar(), "年柱", &q.Year},
+		{req.GetMonthPillar(), "月柱", &q.Month},
+		{req.GetDayPillar(), "日柱", &q.Day},
+		{req.GetHourPillar(), "时柱", &q.Hour},
+	} {
+		if f.text == "" {
+			continue
+		}
+		p, ok := ParsePillar(f.text)
+		if !ok {
+			return &pb.ReverseLookupResponse{Code: 1002, Message: f.label + "不合法，应为六十甲子之一，如“甲子”"}, nil
+		}
+		*f.dst = &p
+	}
+	if q.empty() {
+		return &pb.ReverseLookupResponse{Code: 1002, Message: "请至少填写一柱"}, nil
+	}
+
+	from, to := int(req.GetStartYear()), int(req.GetEndYear())
+	if from < solarTermMinYear || to > solarTermMaxYear || from > to {
+		return &pb.ReverseLookupResponse{
+			Code:    1002,
+			Message: fmt.Sprintf("年份范围不合法，支持 %d 至 %d 年", solarTermMinYear, solarTermMaxYear),
+		}, nil
+	}
+	if to-from+1 > maxReverseLookupYears {
+		return &pb.ReverseLookupResponse{
+			Code:    1002,
+			Message: fmt.Sprintf("年份跨度不能超过 %d 年", maxReverseLookupYears),
+		}, nil
+	}
+
+	db, ok := dayBoundaryFromProto(req.GetDayBoundary())
+	if !ok {
+		return &pb.ReverseLookupResponse{Code: 1002, Message: "子时换日方式不合法"}, nil
+	}
+
+	var clock solarClock
+	if req.Longitude != nil {
+		lon := req.GetLongitude()
+		if math.IsNaN(lon) || lon < -180 || lon > 180 {
+			return &pb.ReverseLookupResponse{Code: 1002, Message: "经度不合法"}, nil
+		}
+		clock = solarClock{longitude: lon, hasLongitude: true}
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultReverseLookupMax
+	}
+	if limit > maxReverseLookupMax {
+		limit = maxReverseLookupMax
+	}
+
+	ws, truncated, err := FindBirthWindows(ctx, q, from, to, clock, db, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ReverseLookupResponse{
+		Code:        0,
+		Message:     "ok",
+		Truncated:   truncated,
+		DayBoundary: db.String(),
+	}
+	// Window edges are given as the clock readings Reasoning takes for a birth in
+	// China, so that they give back the same chart.
+	reading := func(t time.Time) string {
+		r, _ := ChinaCivilReading(t, "", clock.longitude, clock.hasLongitude)
+		return r.Format("2006-01-02 15:04:05")
+	}
+	for _, w := range ws {
+		resp.Windows = append(resp.Windows, &pb.DatetimeWindow{
+			Bazi:           w.Pillars.String(),
+			Start:          reading(w.From),
+			End:            reading(w.To),
+			StartUnix:      w.From.Unix(),
+			EndUnix:        w.To.Unix(),
+			TrueSolarStart: w.SolarFrom.Format("2006-01-02 15:04:05"),
+			TrueSolarEnd:   w.SolarTo.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package bazi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "llyb-backend/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// A clock reading inside a returned window, read back the way Reasoning reads a
+// birth (civil time, then solar time), gives the chart that was looked up: under
+// summer time, in the Republican zones and in local mean time.
+func TestReverseLookupRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		year   int32
+		lon    float64
+		hasLon bool
+	}{
+		{1988, 0, false},
+		{1988, 104.1, true},
+		{1940, 0, false},
+		{1900, 104.1, true},
+	} {
+		req := &pb.ReverseLookupRequest{DayPillar: "甲子", StartYear: tc.year, EndYear: tc.year}
+		if tc.hasLon {
+			req.Longitude = proto.Float64(tc.lon)
+		}
+		resp, err := ReverseLookup(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetCode() != 0 || len(resp.GetWindows()) == 0 {
+			t.Fatalf("%d: code %d (%s), %d windows", tc.year, resp.GetCode(), resp.GetMessage(), len(resp.GetWindows()))
+		}
+		db, _ := dayBoundaryFromProto(pb.DayBoundary_DAY_BOUNDARY_UNSPECIFIED)
+		for _, w := range resp.GetWindows() {
+			start, err1 := time.Parse("2006-01-02 15:04:05", w.GetStart())
+			end, err2 := time.Parse("2006-01-02 15:04:05", w.GetEnd())
+			if err1 != nil || err2 != nil {
+				t.Fatalf("window %s..%s: %v %v", w.GetStart(), w.GetEnd(), err1, err2)
+			}
+			// Reasoning takes whole minutes.
+			clock := start.Add(time.Minute - 1).Truncate(time.Minute)
+			if !clock.Before(end) {
+				continue
+			}
+			instant, rule, err := ChinaCivilTime(clock.Format("2006-01-02"), clock.Format("15:04"), "", tc.lon, tc.hasLon)
+			if err != nil {
+				t.Fatal(err)
+			}
+			solar := instant.In(time.FixedZone("", int(rule.StandardOffset()/time.Second)))
+			if tc.hasLon {
+				solar = trueSolarTime(instant, tc.lon)
+			}
+			if got := ComputePillars(instant, solar, db).String(); got != w.GetBazi() {
+				t.Errorf("%s (%s): chart %s, window %s", clock.Format("2006-01-02 15:04"), rule.Name, got, w.GetBazi())
+			}
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package bazi
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Table-driven 神煞 (auxiliary stars). The rules live in data/shensha.csv so that new
+// stars need no code change; see the header of that file for the format.
+
+//go:embed data/shensha.csv
+var shenShaCSV string
+
+// StarHit is a star found on a pillar, with the rule that triggered it.
+type StarHit struct {
+	Name string
+	// Rule explains the trigger, e.g. "日干甲见丑".
+	Rule string
+}
+
+type shenShaBasis int
+
+const (
+	basisNone shenShaBasis = iota
+	basisDayStem
+	basisYearStem
+	basisMonthBranch
+	basisDayBranch
+	basisYearBranch
+)
+
+var shenShaBases = map[string]shenShaBasis{
+	"none":         basisNone,
+	"day_stem":     basisDayStem,
+	"year_stem":    basisYearStem,
+	"month_branch": basisMonthBranch,
+	"day_branch":   basisDayBranch,
+	"year_branch":  basisYearBranch,
+}
+
+var shenShaBasisLabels = map[shenShaBasis]string{
+	basisDayStem:     "日干",
+	basisYearStem:    "年干",
+	basisMonthBranch: "月支",
+	basisDayBranch:   "日支",
+	basisYearBranch:  "年支",
+}
+
+// key returns the character the basis reads from the natal chart.
+func (b shenShaBasis) key(p FourPillars) string {
+	switch b {
+	case basisDayStem:
+		return p.Day.Stem.String()
+	case basisYearStem:
+		return p.Year.Stem.String()
+	case basisMonthBranch:
+		return p.Month.Branch.String()
+	case basisDayBranch:
+		return p.Day.Branch.String()
+	case basisYearBranch:
+		return p.Year.Branch.String()
+	}
+	return "*"
+}
+
+type shenShaRule struct {
+	name      string
+	bases     []shenShaBasis
+	target    string            // "branch" | "stem" | "stem_or_branch" | "pillar"
+	positions map[Position]bool // nil: all
+	table     map[string][]string
+}
+
+type shenShaRules []shenShaRule
+
+var loadShenSha = sync.OnceValues(func() (shenShaRules, error) {
+	r := csv.NewReader(strings.NewReader(shenShaCSV))
+	r.Comment = '#'
+	r.FieldsPerRecord = 5
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("shensha: %w", err)
+	}
+	var rules shenShaRules
+	for i, rec := range records {
+		if i == 0 {
+			continue // header
+		}
+		rule, err := parseShenShaRule(rec)
+		if err != nil {
+			return nil, fmt.Errorf("shensha: %s: %w", rec[0], err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+})
+
+func parseShenShaRule(rec []string) (shenShaRule, error) {
+	rule := shenShaRule{name: strings.TrimSpace(rec[0]), target: strings.TrimSpace(rec[2]), table: map[string][]string{}}
+	for _, s := range strings.Split(rec[1], "|") {
+		b, ok := shenShaBases[strings.TrimSpace(s)]
+		if !ok {
+			return rule, fmt.Errorf("unknown basis %q", s)
+		}
+		rule.bases = append(rule.bases, b)
+	}
+	width := 1
+	switch rule.target {
+	case "branch", "stem", "stem_or_branch":
+	case "pillar":
+		width = 2
+	default:
+		return rule, fmt.Errorf("unknown target %q", rule.target)
+	}
+	if ps := strings.TrimSpace(rec[3]); ps != "" {
+		rule.positions = map[Position]bool{}
+		for _, s := range strings.Split(ps, "|") {
+			found := false
+			for i, name := range positionNames {
+				if name == strings.TrimSpace(s) {
+					rule.positions[Position(i)] = true
+					found = true
+				}
+			}
+			if !found {
+				return rule, fmt.Errorf("unknown position %q", s)
+			}
+		}
+	}
+	for _, entry := range strings.Fields(rec[4]) {
+		keys, values, ok := strings.Cut(entry, ":")
+		vs := []rune(values)
+		if !ok || keys == "" || len(vs) == 0 || len(vs)%width != 0 {
+			return rule, fmt.Errorf("malformed entry %q", entry)
+		}
+		for _, k := range keys {
+			for i := 0; i < len(vs); i += width {
+				rule.table[string(k)] = append(rule.table[string(k)], string(vs[i:i+width]))
+			}
+		}
+	}
+	return rule, nil
+}
+
+// match returns the stars that rules place on pillar pp of chart p.
+func (rules shenShaRules) match(p FourPillars, pp PlacedPillar) []StarHit {
+	var hits []StarHit
+	for _, rule := range rules {
+		if rule.positions != nil && !rule.positions[pp.Position] {
+			continue
+		}
+		var candidates []string
+		switch rule.target {
+		case "branch":
+			candidates = []string{pp.Pillar.Branch.String()}
+		case "stem":
+			candidates = []string{pp.Pillar.Stem.String()}
+		case "stem_or_branch":
+			candidates = []string{pp.Pillar.Stem.String(), pp.Pillar.Branch.String()}
+		case "pillar":
+			candidates = []string{pp.Pillar.String()}
+		}
+	bases:
+		for _, b := range rule.bases {
+			key := b.key(p)
+			for _, v := range rule.table[key] {
+				for _, c := range candidates {
+					if c != v {
+						continue
+					}
+					trigger := shenShaBasisLabels[b] + key + "见" + v
+					if b == basisNone {
+						trigger = "见" + v
+					}
+					hits = append(hits, StarHit{Name: rule.name, Rule: trigger})
+					// One hit per star and pillar, from the first basis that triggers it.
+					break bases
+				}
+			}
+		}
+	}
+	return hits
+}
@@ -0,0 +1,128 @@
+package bazi
+
+import (
+	"context"
+	"math"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// SolarTerm is one of the 24 solar terms (二十四节气), numbered in calendar order
+// from 小寒 (0) to 冬至 (23).
+type SolarTerm int
+
+var solarTermNames = [24]string{
+	"小寒", "大寒", "立春", "雨水", "惊蛰", "春分",
+	"清明", "谷雨", "立夏", "小满", "芒种", "夏至",
+	"小暑", "大暑", "立秋", "处暑", "白露", "秋分",
+	"寒露", "霜降", "立冬", "小雪", "大雪", "冬至",
+}
+
+func (st SolarTerm) String() string { return solarTermNames[mod(int(st), 24)] }
+
+// Longitude returns the apparent solar longitude (degrees) that defines the term.
+func (st SolarTerm) Longitude() float64 {
+	return modf(285+15*float64(st), 360)
+}
+
+// IsJie reports whether the term is a 节 (month boundary of the solar calendar, e.g. 立春)
+// rather than a 中气 (e.g. 雨水).
+func (st SolarTerm) IsJie() bool { return st%2 == 0 }
+
+// Time returns the instant (UTC, rounded to the second) at which the Sun reaches the
+// term's longitude in the given Gregorian year.
+func (st SolarTerm) Time(year int) time.Time {
+	// Initial guess from the mean motion of the Sun; 小寒 falls around Jan 5-6.
+	jde := julianDay(time.Date(year, time.January, 6, 0, 0, 0, 0, time.UTC)) + float64(st)*tropicalYearDays/24
+	return sunLongitudeTime(st.Longitude(), jde)
+}
+
+// sunLongitudeTime returns the instant (UTC, rounded to the second) at which the
+// apparent solar longitude reaches target, searching from the guess jde (within a
+// few weeks of the answer).
+func sunLongitudeTime(target, jde float64) time.Time {
+	for i := 0; i < 20; i++ {
+		d := modf(target-sunApparentLongitude(jde)+180, 360) - 180
+		jde += d * tropicalYearDays / 360
+		if math.Abs(d) < 1e-7 {
+			break
+		}
+	}
+	jd := jde - deltaTSeconds(decimalYear(jde))/86400.0
+	return julianDayToTime(jd).Round(time.Second)
+}
+
+// JieAround returns the 节 (solar month boundaries) at or before t and after t.
+func JieAround(t time.Time) (prev, next SolarTermEvent) {
+	jde := julianEphemerisDay(t)
+	lon := sunApparentLongitude(jde)
+	m := math.Floor(modf(lon-315, 360) / 30) // solar months since 立春
+	prevLon := modf(315+30*m, 360)
+	nextLon := modf(prevLon+30, 360)
+	prev.Term = solarTermAt(prevLon)
+	prev.Time = sunLongitudeTime(prevLon, jde-modf(lon-prevLon, 360)*tropicalYearDays/360)
+	next.Term = solarTermAt(nextLon)
+	next.Time = sunLongitudeTime(nextLon, jde+modf(nextLon-lon, 360)*tropicalYearDays/360)
+	return prev, next
+}
+
+// solarTermAt returns the term defined by a longitude multiple of 15°.
+func solarTermAt(lon float64) SolarTerm {
+	return SolarTerm(mod(int(math.Round((lon-285)/15)), 24))
+}
+
+const tropicalYearDays = 365.2422
+
+// SolarTermEvent is a solar term together with the instant it begins.
+type SolarTermEvent struct {
+	Term SolarTerm
+	Time time.Time
+}
+
+// SolarTermsOfYear returns all 24 solar terms of a Gregorian year, from 小寒 to 冬至.
+func SolarTermsOfYear(year int) []SolarTermEvent {
+	out := make([]SolarTermEvent, 0, 24)
+	for st := SolarTerm(0); st < 24; st++ {
+		out = append(out, SolarTermEvent{Term: st, Time: st.Time(year)})
+	}
+	return out
+}
+
+// Supported range for the solar term RPC. The ephemeris itself is usable further out,
+// but ΔT is only an extrapolation there.
+const (
+	solarTermMinYear = 1000
+	solarTermMaxYear = 3000
+)
+
+// SolarTerms is the backend handler for "/bazi/solar-terms?year=".
+// Times are reported in Beijing time.
+func SolarTerms(ctx context.Context, req *pb.SolarTermsRequest) (*pb.SolarTermsResponse, error) {
+	year := int(req.GetYear())
+	if year < solarTermMinYear || year > solarTermMaxYear {
+		return &pb.SolarTermsResponse{
+			Code:    1002,
+			Message: "参数不合法",
+		}, nil
+	}
+
+	events := SolarTermsOfYear(year)
+	terms := make([]*pb.SolarTermInfo, 0, len(events))
+	for _, ev := range events {
+		terms = append(terms, &pb.SolarTermInfo{
+			Index:        int32(ev.Term),
+			Name:         ev.Term.String(),
+			LongitudeDeg: ev.Term.Longitude(),
+			IsJie:        ev.Term.IsJie(),
+			Time:         ev.Time.In(beijing).Format("2006-01-02 15:04:05"),
+			Unix:         ev.Time.Unix(),
+		})
+	}
+	return &pb.SolarTermsResponse{
+		Code:    0,
+		Message: "ok",
+		Year:    int32(year),
+		Terms:   terms,
+	}, nil
+}
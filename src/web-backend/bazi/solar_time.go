@@ -19,8 +19,9 @@ import (
 // Model:
 //
 //	true_solar_time = beijing_time + 4*(lon-120) minutes + EoT(date)
+//	                = utc + 4*lon minutes + EoT(date)
 //
-// where EoT is the equation of time in minutes (approximation).
+// where EoT is the equation of time in minutes at that instant (see SunPosition).
 func TrueSolarTimeFromBeijing(solarDate, birthTime string, longitudeDeg float64) (string, error) {
 	if solarDate == "" || birthTime == "" {
 		return "", fmt.Errorf("empty solarDate/birthTime")
@@ -29,31 +30,41 @@ func TrueSolarTimeFromBeijing(solarDate, birthTime string, longitudeDeg float64)
 		return "", fmt.Errorf("invalid longitude")
 	}
 
-	// Avoid depending on system tzdata. Beijing time is fixed UTC+08:00.
-	bj := time.FixedZone("CST", 8*3600)
-	t, err := time.ParseInLocation("2006-01-02 15:04", solarDate+" "+birthTime, bj)
+	t, err := parseBeijingTime(solarDate, birthTime)
 	if err != nil {
 		return "", err
 	}
 
-	// Longitude correction relative to Beijing standard meridian (120E).
-	lonMinutes := 4.0 * (longitudeDeg - 120.0)
+	return trueSolarTime(t, longitudeDeg).Format("2006/01/02 15:04"), nil
+}
+
+// trueSolarTime converts an instant to local true solar time at the given longitude
+// (degrees East, negative for West). The result is expressed in a zero-offset "LAT"
+// zone so that its wall clock reads as local apparent time.
+func trueSolarTime(t time.Time, longitudeDeg float64) time.Time {
+	// Longitude correction relative to the Greenwich meridian.
+	lonMinutes := 4.0 * longitudeDeg
 	eotMinutes := equationOfTimeMinutes(t)
 	corrMinutes := lonMinutes + eotMinutes
 
 	// Apply correction with rounding to the nearest second.
 	sec := int64(math.Round(corrMinutes * 60.0))
-	out := t.Add(time.Duration(sec) * time.Second)
+	out := t.UTC().Add(time.Duration(sec) * time.Second)
+
+	return out.In(apparentSolarZone)
+}
+
+var apparentSolarZone = time.FixedZone("LAT", 0)
+
+// Avoid depending on system tzdata. Beijing time is fixed UTC+08:00.
+var beijing = time.FixedZone("CST", 8*3600)
 
-	return out.In(bj).Format("2006/01/02 15:04"), nil
+// parseBeijingTime parses "YYYY-MM-DD" + "HH:mm" as Beijing standard time.
+func parseBeijingTime(solarDate, birthTime string) (time.Time, error) {
+	return time.ParseInLocation("2006-01-02 15:04", solarDate+" "+birthTime, beijing)
 }
 
-// equationOfTimeMinutes returns the equation of time in minutes for the given date.
-// Approximation formula with typical error on the order of ~<1 minute.
+// equationOfTimeMinutes returns the equation of time in minutes at the given instant.
 func equationOfTimeMinutes(t time.Time) float64 {
-	// Use day-of-year for the given local date (Beijing date is fine for the purposes here).
-	n := float64(t.YearDay())
-	b := 2 * math.Pi * (n - 81) / 364.0
-	// Minutes.
-	return 9.87*math.Sin(2*b) - 7.53*math.Cos(b) - 1.5*math.Sin(b)
+	return SunPosition(t).EquationOfTime
 }
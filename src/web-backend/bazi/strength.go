@@ -0,0 +1,202 @@
+package bazi
+
+import "sort"
+
+// Five-element (五行) strength scoring and the day master's 旺衰.
+//
+// Every stem in the chart contributes to its element: the four visible stems with
+// weight 1, and the hidden stems (藏干) of each branch sharing the branch weight (the
+// month branch counts double, as 月令 dominates). Each contribution is scaled by the
+// element's seasonal state (旺相休囚死) relative to the month branch, and visible
+// stems without roots (通根) in any branch are discounted as floating (虚浮).
+
+const (
+	stemWeight        = 1.0
+	branchWeight      = 1.0
+	monthBranchWeight = 2.0
+	// A visible stem keeps rootlessFactor of its weight with no root, rising linearly
+	// to full weight once its roots add up to one main qi.
+	rootlessFactor = 0.7
+)
+
+// SeasonalState is an element's state (旺相休囚死) relative to the month branch.
+type SeasonalState int
+
+const (
+	Prosperous SeasonalState = iota // 旺: same as the season
+	Assisted                        // 相: generated by the season
+	Resting                         // 休: generates the season
+	Confined                        // 囚: controls the season
+	Dead                            // 死: controlled by the season
+)
+
+var seasonalStateNames = [5]string{"旺", "相", "休", "囚", "死"}
+
+var seasonalFactors = [5]float64{1.4, 1.2, 1.0, 0.8, 0.6}
+
+func (s SeasonalState) String() string { return seasonalStateNames[s] }
+
+// SeasonalStateOf returns e's state in the season of the month branch.
+func SeasonalStateOf(e Element, month Branch) SeasonalState {
+	season := month.Element()
+	switch e {
+	case season:
+		return Prosperous
+	case season.Generates():
+		return Assisted
+	case season.Controls():
+		return Dead
+	}
+	if e.Generates() == season {
+		return Resting
+	}
+	return Confined
+}
+
+// StrengthCategory classifies the day master: 极弱, 偏弱, 中和, 偏强, 极强.
+type StrengthCategory int
+
+const (
+	VeryWeak StrengthCategory = iota
+	Weak
+	Balanced
+	Strong
+	VeryStrong
+)
+
+var strengthCategoryNames = [5]string{"极弱", "偏弱", "中和", "偏强", "极强"}
+
+func (c StrengthCategory) String() string { return strengthCategoryNames[c] }
+
+// Upper bounds of the support ratio for VeryWeak..Strong; above the last is VeryStrong.
+var strengthThresholds = [4]float64{0.20, 0.37, 0.50, 0.75}
+
+// ElementAnalysis is the outcome of AnalyzeElements.
+type ElementAnalysis struct {
+	Scores  [5]float64       // weighted score per element, indexed by Element
+	Percent [5]float64       // Scores as percentages of their sum
+	Counts  [5]int           // visible characters per element (4 stems + 4 branches)
+	Missing []Element        // elements absent from all eight characters (五行缺)
+	Season  Element          // element in command (当令)
+	States  [5]SeasonalState // seasonal state per element
+
+	DayMaster Stem
+	// Support is the score of the day master's own element (比劫) plus the element
+	// generating it (印); Ratio is Support over the total.
+	Support  float64
+	Ratio    float64
+	Category StrengthCategory
+	// 得令: the month branch's main qi supports the day master.
+	// 得地: the day master is rooted in some branch.
+	// 得势: supporting visible stems (besides the day master) outnumber the others.
+	DeLing, DeDi, DeShi bool
+
+	// Favourable (喜用) and unfavourable (忌) elements by the 扶抑 method: a strong day
+	// master wants output, wealth and officers; a weak one wants resource and peers.
+	Favourable   []Element
+	Unfavourable []Element
+}
+
+// AnalyzeElements scores the five elements of a chart and judges the day master.
+func AnalyzeElements(p FourPillars) ElementAnalysis {
+	var a ElementAnalysis
+	a.DayMaster = p.Day.Stem
+	a.Season = p.Month.Branch.Element()
+	for e := Wood; e <= Water; e++ {
+		a.States[e] = SeasonalStateOf(e, p.Month.Branch)
+	}
+
+	pillars := p.pillars()
+	for i, pl := range pillars {
+		a.Counts[pl.Stem.Element()]++
+		a.Counts[pl.Branch.Element()]++
+
+		roots := stemRoots(pl.Stem, p)
+		if roots > 1 {
+			roots = 1
+		}
+		a.Scores[pl.Stem.Element()] += stemWeight * (rootlessFactor + (1-rootlessFactor)*roots) *
+			seasonalFactors[a.States[pl.Stem.Element()]]
+
+		w := branchWeight
+		if i == 1 {
+			w = monthBranchWeight
+		}
+		hidden := branchHiddenStems[pl.Branch]
+		for j, h := range hidden {
+			a.Scores[h.Element()] += w * hiddenStemWeights[len(hidden)][j] * seasonalFactors[a.States[h.Element()]]
+		}
+	}
+
+	var total float64
+	for _, s := range a.Scores {
+		total += s
+	}
+	for e := range a.Scores {
+		a.Percent[e] = a.Scores[e] / total * 100
+		if a.Counts[e] == 0 {
+			a.Missing = append(a.Missing, Element(e))
+		}
+	}
+
+	self := a.DayMaster.Element()
+	resource := Element(mod(int(self)+4, 5)) // the element generating self
+	a.Support = a.Scores[self] + a.Scores[resource]
+	a.Ratio = a.Support / total
+	a.Category = VeryStrong
+	for c, t := range strengthThresholds {
+		if a.Ratio < t {
+			a.Category = StrengthCategory(c)
+			break
+		}
+	}
+
+	monthMain := branchHiddenStems[p.Month.Branch][0].Element()
+	a.DeLing = monthMain == self || monthMain == resource
+	a.DeDi = stemRoots(a.DayMaster, p) > 0
+	supporting := 0
+	for i, pl := range pillars {
+		if i == 2 {
+			continue
+		}
+		if e := pl.Stem.Element(); e == self || e == resource {
+			supporting++
+		}
+	}
+	a.DeShi = supporting >= 2
+
+	helpers := []Element{self, resource}
+	drains := []Element{self.Generates(), self.Controls(), Element(mod(int(self)+3, 5))}
+	switch {
+	case a.Category >= Strong:
+		a.Favourable, a.Unfavourable = drains, helpers
+	case a.Category <= Weak:
+		a.Favourable, a.Unfavourable = helpers, drains
+	default:
+		// Balanced: lean towards the weakest elements and away from the strongest.
+		order := []Element{Wood, Fire, Earth, Metal, Water}
+		sort.SliceStable(order, func(i, j int) bool { return a.Scores[order[i]] < a.Scores[order[j]] })
+		a.Favourable = order[:2]
+		a.Unfavourable = order[4:]
+	}
+	return a
+}
+
+// stemRoots measures s's roots (通根): over all four branches, the hidden stems
+// sharing s's element, each weighted relative to its branch's main qi (so a main-qi
+// root counts 1).
+func stemRoots(s Stem, p FourPillars) float64 {
+	var r float64
+	for _, pl := range p.pillars() {
+		hidden := branchHiddenStems[pl.Branch]
+		for j, h := range hidden {
+			if h.Element() == s.Element() {
+				r += hiddenStemWeights[len(hidden)][j] / hiddenStemWeights[len(hidden)][0]
+			}
+		}
+	}
+	return r
+}
+
+// pillars returns the four pillars in year, month, day, hour order.
+func (p FourPillars) pillars() [4]Pillar { return [4]Pillar{p.Year, p.Month, p.Day, p.Hour} }
@@ -0,0 +1,106 @@
+package bazi
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	deg    = math.Pi / 180
+	arcsec = deg / 3600
+)
+
+// julianDay returns the Julian Day (UT) of the given instant.
+func julianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400.0 + float64(t.Nanosecond())/86400e9 + 2440587.5
+}
+
+// SolarPosition is the apparent geocentric position of the Sun at an instant.
+//
+// Model (Meeus, "Astronomical Algorithms" ch.22, 25 and 28): truncated VSOP87 Earth
+// position converted to the FK5 frame, with nutation and annual aberration applied.
+// Longitude is good to about 1", the equation of time to well under a second.
+type SolarPosition struct {
+	JDE float64 // Julian Ephemeris Day (TT) of the instant
+
+	Longitude float64 // apparent ecliptic longitude, degrees [0, 360)
+	Latitude  float64 // apparent ecliptic latitude, degrees
+	Distance  float64 // Earth-Sun distance, AU
+
+	RightAscension float64 // apparent right ascension, degrees [0, 360)
+	Declination    float64 // apparent declination, degrees
+	Obliquity      float64 // true obliquity of the ecliptic, degrees
+
+	// EquationOfTime is apparent minus mean solar time, in minutes.
+	// Positive means the sundial is ahead of the clock.
+	EquationOfTime float64
+}
+
+// SunPosition returns the Sun's apparent position at the given instant.
+func SunPosition(t time.Time) SolarPosition {
+	return sunPositionJDE(julianEphemerisDay(t))
+}
+
+func sunPositionJDE(jde float64) SolarPosition {
+	l, b, r := earthHeliocentric(jde)
+	t := (jde - 2451545.0) / 36525.0
+	tau := t / 10
+
+	// Geocentric coordinates of the Sun.
+	lambda := l + math.Pi
+	beta := -b
+
+	// Conversion to FK5 (Meeus 25.9).
+	lp := lambda - (1.397*t+0.00031*t*t)*deg
+	lambda += -0.09033 * arcsec
+	beta += 0.03916 * arcsec * (math.Cos(lp) - math.Sin(lp))
+
+	dpsi, deps := nutation(t)
+	eps := meanObliquity(t) + deps
+
+	// Apparent longitude: nutation and aberration.
+	lambda += dpsi - 20.4898*arcsec/r
+
+	ra := math.Atan2(math.Sin(lambda)*math.Cos(eps)-math.Tan(beta)*math.Sin(eps), math.Cos(lambda))
+	dec := math.Asin(math.Sin(beta)*math.Cos(eps) + math.Cos(beta)*math.Sin(eps)*math.Sin(lambda))
+
+	// Equation of time (Meeus 28.3), with the Sun's mean longitude from VSOP87.
+	l0 := 280.4664567 + 360007.6982779*tau + 0.03032028*tau*tau +
+		tau*tau*tau/49931 - tau*tau*tau*tau/15300 - tau*tau*tau*tau*tau/2000000
+	e := l0 - 0.0057183 - ra/deg + dpsi/deg*math.Cos(eps)
+	e = modf(e+180, 360) - 180
+
+	return SolarPosition{
+		JDE:            jde,
+		Longitude:      modf(lambda/deg, 360),
+		Latitude:       beta / deg,
+		Distance:       r,
+		RightAscension: modf(ra/deg, 360),
+		Declination:    dec / deg,
+		Obliquity:      eps / deg,
+		EquationOfTime: e * 4, // 1° of hour angle = 4 minutes
+	}
+}
+
+// sunApparentLongitude returns the apparent geocentric ecliptic longitude of the Sun
+// in degrees [0, 360) for the given Julian Ephemeris Day (TT).
+func sunApparentLongitude(jde float64) float64 {
+	return sunPositionJDE(jde).Longitude
+}
+
+// nutation returns the nutation in longitude Δψ and in obliquity Δε (radians) for
+// T Julian centuries from J2000.0 (TT). Uses the leading terms of the IAU 1980
+// series (Meeus ch.22, ~0.5" in Δψ and ~0.1" in Δε).
+func nutation(t float64) (dpsi, deps float64) {
+	omega := (125.04452 - 1934.136261*t) * deg
+	ls := (280.4665 + 36000.7698*t) * deg  // mean longitude of the Sun
+	lm := (218.3165 + 481267.8813*t) * deg // mean longitude of the Moon
+	dpsi = (-17.20*math.Sin(omega) - 1.32*math.Sin(2*ls) - 0.23*math.Sin(2*lm) + 0.21*math.Sin(2*omega)) * arcsec
+	deps = (9.20*math.Cos(omega) + 0.57*math.Cos(2*ls) + 0.10*math.Cos(2*lm) - 0.09*math.Cos(2*omega)) * arcsec
+	return dpsi, deps
+}
+
+// meanObliquity returns the mean obliquity of the ecliptic (radians), IAU 1980 (Meeus 22.2).
+func meanObliquity(t float64) float64 {
+	return (23*3600 + 26*60 + 21.448 - 46.8150*t - 0.00059*t*t + 0.001813*t*t*t) * arcsec
+}
@@ -0,0 +1,59 @@
+package bazi
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// Meeus, "Astronomical Algorithms", examples 25.a and 28.a: 1992 October 13.0 TD.
+func TestSunPositionMeeus(t *testing.T) {
+	p := sunPositionJDE(2448908.5)
+	if want := 199.906060; math.Abs(p.Longitude-want) > 1.0/3600 {
+		t.Errorf("apparent longitude = %.6f°, want %.6f°", p.Longitude, want)
+	}
+	if want := 13.71; math.Abs(p.EquationOfTime-want) > 0.01 {
+		t.Errorf("equation of time = %.3f min, want %.2f min", p.EquationOfTime, want)
+	}
+}
+
+// Solar term instants published by the Purple Mountain Observatory (Beijing time).
+func TestSolarTermTimes(t *testing.T) {
+	tests := []struct {
+		term SolarTerm
+		year int
+		want string
+	}{
+		{2, 2023, "2023-02-04 10:42:21"},  // 立春
+		{2, 2024, "2024-02-04 16:26:53"},  // 立春
+		{2, 2025, "2025-02-03 22:10:28"},  // 立春
+		{23, 2022, "2022-12-22 05:48:01"}, // 冬至
+		{23, 2023, "2023-12-22 11:27:09"}, // 冬至
+		{23, 2024, "2024-12-21 17:20:34"}, // 冬至
+	}
+	for _, tt := range tests {
+		want, err := time.ParseInLocation("2006-01-02 15:04:05", tt.want, beijing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := tt.term.Time(tt.year)
+		if d := got.Sub(want); d < -time.Minute || d > time.Minute {
+			t.Errorf("%s %d = %s, want %s", tt.term, tt.year, got.In(beijing).Format("2006-01-02 15:04:05"), tt.want)
+		}
+	}
+}
+
+// ΔT against the Espenak–Meeus table (seconds; older values are rounded to 10 s),
+// and without jumps of a second or more where one fit hands over to the next.
+func TestDeltaT(t *testing.T) {
+	for y, want := range map[float64]float64{1000: 1570, 1200: 740, 1400: 320, 1600: 120, 1650: 50, 1700: 9, 1750: 13, 1800: 14, 1900: -3, 2000: 64} {
+		if got := deltaTSeconds(y); math.Abs(got-want) > 5 {
+			t.Errorf("ΔT(%v) = %.1f s, want %v s", y, got, want)
+		}
+	}
+	for _, y := range []float64{1600, 1700, 1800, 1860, 1900, 1920, 1941, 1961, 1986, 2005, 2050, 2150} {
+		if d := deltaTSeconds(y) - deltaTSeconds(y-1e-9); math.Abs(d) >= 1 {
+			t.Errorf("ΔT jumps by %.2f s at %v", d, y)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package bazi
+
+// TenGod is a stem's relation to the day master (十神), determined by element and
+// polarity: peers, output, wealth, officer and resource, each same- or
+// opposite-polarity.
+type TenGod int
+
+const (
+	Companion        TenGod = iota // 比肩: same element, same polarity
+	RobWealth                      // 劫财: same element, opposite polarity
+	EatingGod                      // 食神: generated by the day master, same polarity
+	HurtingOfficer                 // 伤官: generated by the day master, opposite polarity
+	IndirectWealth                 // 偏财: controlled by the day master, same polarity
+	DirectWealth                   // 正财: controlled by the day master, opposite polarity
+	SevenKillings                  // 七杀: controls the day master, same polarity
+	DirectOfficer                  // 正官: controls the day master, opposite polarity
+	IndirectResource               // 偏印: generates the day master, same polarity
+	DirectResource                 // 正印: generates the day master, opposite polarity
+)
+
+var tenGodNames = [10]string{"比肩", "劫财", "食神", "伤官", "偏财", "正财", "七杀", "正官", "偏印", "正印"}
+
+func (g TenGod) String() string { return tenGodNames[g] }
+
+// TenGodOf returns the Ten God of stem s for the given day master.
+func TenGodOf(dayMaster, s Stem) TenGod {
+	// Steps along the generating cycle from the day master: 0 peer, 1 output,
+	// 2 wealth, 3 officer, 4 resource.
+	rel := mod(int(s.Element())-int(dayMaster.Element()), 5)
+	g := TenGod(rel * 2)
+	if s.IsYang() != dayMaster.IsYang() {
+		g++
+	}
+	return g
+}
+
+// HiddenStemGod is a hidden stem with its Ten God.
+type HiddenStemGod struct {
+	HiddenStem
+	God TenGod
+}
+
+// PillarGods holds the Ten Gods of one pillar's stem and hidden stems.
+type PillarGods struct {
+	Pillar Pillar
+	// StemGod is meaningless for the day pillar, whose stem is the day master (日主).
+	StemGod     TenGod
+	IsDayMaster bool
+	Hidden      []HiddenStemGod
+}
+
+// ChartTenGods derives the Ten Gods of every stem and hidden stem, in year, month,
+// day, hour order.
+func ChartTenGods(p FourPillars) [4]PillarGods {
+	dm := p.Day.Stem
+	var out [4]PillarGods
+	for i, pl := range p.pillars() {
+		pg := PillarGods{Pillar: pl, StemGod: TenGodOf(dm, pl.Stem), IsDayMaster: i == 2}
+		for _, h := range HiddenStems(pl.Branch) {
+			pg.Hidden = append(pg.Hidden, HiddenStemGod{HiddenStem: h, God: TenGodOf(dm, h.Stem)})
+		}
+		out[i] = pg
+	}
+	return out
+}
@@ -0,0 +1,140 @@
+package bazi
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	// Embed the IANA time zone database so the container needs no system zoneinfo.
+	_ "time/tzdata"
+)
+
+// countryTimeZones maps ISO 3166-1 alpha-2 codes to the IANA zone used when the
+// caller does not send one. Only countries with a single civil time zone are listed;
+// multi-zone countries (US, CA, RU, AU, BR, MX, ID, ...) require an explicit zone.
+var countryTimeZones = map[string]string{
+	"HK": "Asia/Hong_Kong",
+	"MO": "Asia/Macau",
+	"TW": "Asia/Taipei",
+	"JP": "Asia/Tokyo",
+	"KR": "Asia/Seoul",
+	"KP": "Asia/Pyongyang",
+	"MN": "Asia/Ulaanbaatar",
+	"SG": "Asia/Singapore",
+	"MY": "Asia/Kuala_Lumpur",
+	"TH": "Asia/Bangkok",
+	"VN": "Asia/Ho_Chi_Minh",
+	"PH": "Asia/Manila",
+	"KH": "Asia/Phnom_Penh",
+	"LA": "Asia/Vientiane",
+	"MM": "Asia/Yangon",
+	"IN": "Asia/Kolkata",
+	"AE": "Asia/Dubai",
+	"IL": "Asia/Jerusalem",
+	"TR": "Europe/Istanbul",
+	"GB": "Europe/London",
+	"IE": "Europe/Dublin",
+	"FR": "Europe/Paris",
+	"DE": "Europe/Berlin",
+	"IT": "Europe/Rome",
+	"NL": "Europe/Amsterdam",
+	"BE": "Europe/Brussels",
+	"CH": "Europe/Zurich",
+	"AT": "Europe/Vienna",
+	"SE": "Europe/Stockholm",
+	"NO": "Europe/Oslo",
+	"DK": "Europe/Copenhagen",
+	"FI": "Europe/Helsinki",
+	"PL": "Europe/Warsaw",
+	"GR": "Europe/Athens",
+	"NZ": "Pacific/Auckland",
+	"ZA": "Africa/Johannesburg",
+	"EG": "Africa/Cairo",
+}
+
+// isChina reports whether a normalized country code means mainland China.
+func isChina(country string) bool {
+	return country == "CN"
+}
+
+// normalizeCountry upper-cases an ISO 3166-1 alpha-2 code. Empty input and Chinese
+// names for mainland China become "CN".
+func normalizeCountry(s string) string {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "", "中国", "中华人民共和国":
+		return "CN"
+	}
+	return strings.ToUpper(s)
+}
+
+// ResolveTimeZone picks the IANA zone for a birth place.
+//
+// It returns (nil, nil) when the built-in China civil time rules should be used,
+// i.e. for mainland China without an explicit zone (see ChinaCivilTime).
+func ResolveTimeZone(country, zoneName string) (*time.Location, error) {
+	zoneName = strings.TrimSpace(zoneName)
+	if zoneName != "" {
+		loc, err := time.LoadLocation(zoneName)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone %q", zoneName)
+		}
+		return loc, nil
+	}
+	if isChina(country) {
+		return nil, nil
+	}
+	name, ok := countryTimeZones[country]
+	if !ok {
+		return nil, fmt.Errorf("time zone required for country %q", country)
+	}
+	return time.LoadLocation(name)
+}
+
+// ZoneCivilTime interprets a birth's clock reading in an IANA time zone, using the
+// zone's full history (standard offset changes and daylight saving time).
+func ZoneCivilTime(solarDate, birthTime string, loc *time.Location) (time.Time, CivilTimeRule, error) {
+	t, err := time.ParseInLocation("2006-01-02 15:04", solarDate+" "+birthTime, loc)
+	if err != nil {
+		return time.Time{}, CivilTimeRule{}, err
+	}
+	abbr, offset := t.Zone()
+	name := loc.String()
+	if abbr != "" && !strings.HasPrefix(abbr, "+") && !strings.HasPrefix(abbr, "-") {
+		name += " (" + abbr + ")"
+	}
+	rule := CivilTimeRule{Name: name, Offset: time.Duration(offset) * time.Second, DST: t.IsDST()}
+	if rule.DST {
+		rule.DSTSaving = rule.Offset - zoneStandardOffset(t)
+	}
+	return t, rule, nil
+}
+
+// zoneStandardOffset returns the offset of the nearest period of t's zone without
+// daylight saving time, looking back first: 1940s double summer time followed and
+// preceded ordinary summer time. Without one in sight it assumes a one-hour saving.
+func zoneStandardOffset(t time.Time) time.Duration {
+	for _, dir := range []int{-1, 1} {
+		u := t
+		for i := 0; i < 4; i++ {
+			start, end := u.ZoneBounds()
+			if dir < 0 {
+				if start.IsZero() {
+					break
+				}
+				u = start.Add(-time.Second)
+			} else {
+				if end.IsZero() {
+					break
+				}
+				u = end
+			}
+			if !u.IsDST() {
+				_, offset := u.Zone()
+				return time.Duration(offset) * time.Second
+			}
+		}
+	}
+	_, offset := t.Zone()
+	return time.Duration(offset)*time.Second - time.Hour
+}
@@ -0,0 +1,203 @@
+package bazi
+
+import (
+	"context"
+	"fmt"
+
+	pb "llyb-backend/proto"
+)
+
+const (
+	defaultTimelinePageSize = 8
+	// maxAnnualSpan bounds start_year..end_year, as MaxLuckPillars bounds the decades.
+	maxAnnualSpan = 120
+)
+
+// Timeline is the backend handler for "/bazi/timeline": the luck pillars (大运) of a
+// chart, page_size decades at a time, each with the annual pillars (流年) it governs.
+func Timeline(ctx context.Context, req *pb.TimelineRequest) (*pb.TimelineResponse, error) {
+	var male bool
+	switch req.GetBirth().GetGender() {
+	case pb.Gender_GENDER_MALE:
+		male = true
+	case pb.Gender_GENDER_FEMALE:
+	default:
+		return &pb.TimelineResponse{
+			Code:    1002,
+			Message: "请选择性别：大运顺逆由性别与年干阴阳决定",
+		}, nil
+	}
+
+	b, err := resolveBirth(ctx, req.GetBirth())
+	if err != nil {
+		return &pb.TimelineResponse{
+			Code:    1002,
+			Message: err.Error(),
+		}, nil
+	}
+
+	birthYear := BirthYear(b.civil, b.pillars)
+	startYear, endYear := int(req.GetStartYear()), int(req.GetEndYear())
+	spanned := startYear != 0 || endYear != 0
+	if spanned {
+		switch {
+		case startYear == 0 || endYear == 0 || endYear < startYear:
+			return &pb.TimelineResponse{Code: 1002, Message: "流年起止年份不合法"}, nil
+		case startYear < birthYear:
+			return &pb.TimelineResponse{Code: 1002, Message: "流年起始年份不能早于出生年份"}, nil
+		case endYear-startYear+1 > maxAnnualSpan:
+			return &pb.TimelineResponse{Code: 1002, Message: fmt.Sprintf("流年跨度不能超过 %d 年", maxAnnualSpan)}, nil
+		}
+	}
+
+	page := int(req.GetPage())
+	if page <= 0 {
+		page = 1
+	}
+	size := int(req.GetPageSize())
+	if size <= 0 {
+		size = defaultTimelinePageSize
+	}
+	if size > MaxLuckPillars {
+		size = MaxLuckPillars
+	}
+	totalPages := (MaxLuckPillars + size - 1) / size
+
+	// With an uncertain birth time only the pillars all candidates share are shown
+	// and related to the luck and annual pillars. The luck cycle needs the year stem
+	// (direction) and the month pillar (the decades), the ten gods the day master.
+	known := [4]bool{true, true, true, true}
+	if len(b.candidates) > 0 {
+		_, known = commonPillars(b.candidates)
+	}
+	placed := b.pillars.Placed()
+	var natal []PlacedPillar
+	for i, pp := range placed {
+		if known[i] {
+			natal = append(natal, pp)
+		}
+	}
+	chart := natalChart{dm: b.pillars.Day.Stem, hasDM: known[2], hasAge: known[0], birthYear: birthYear}
+
+	resp := &pb.TimelineResponse{
+		Code:        0,
+		Message:     "ok",
+		Bazi:        baziText(placed, known),
+		DayBoundary: b.dayBoundary.String(),
+		Approximate: b.timeMode != "exact",
+	}
+	if !known[0] || !known[1] {
+		if spanned {
+			for _, ap := range AnnualPillars(startYear, endYear) {
+				resp.Annual = append(resp.Annual, chart.annualPillarInfo(natal, ap))
+			}
+		}
+		return resp, nil
+	}
+
+	cycle := ComputeLuckCycle(b.civil, b.pillars, male)
+	resp.Direction = "backward"
+	if cycle.Forward {
+		resp.Direction = "forward"
+	}
+	resp.StartYears = int32(cycle.StartYears)
+	resp.StartMonths = int32(cycle.StartMonths)
+	resp.StartDays = int32(cycle.StartDays)
+	resp.JieName = cycle.Jie.Term.String()
+	resp.JieTime = cycle.Jie.Time.In(b.civil.Location()).Format("2006-01-02 15:04:05")
+	resp.Page = int32(page)
+	resp.PageSize = int32(size)
+	resp.TotalPages = int32(totalPages)
+
+	for i := (page - 1) * size; i < page*size && i < MaxLuckPillars; i++ {
+		lp := cycle.Pillar(i)
+		withLuck := append(natal[:len(natal):len(natal)], PlacedPillar{Position: LuckPos, Pillar: lp.Pillar})
+		info := &pb.LuckPillarInfo{
+			Index:        int32(lp.Index),
+			Pillar:       lp.Pillar.String(),
+			StartAge:     int32(lp.StartAge),
+			StartDate:    lp.Start.Format("2006-01-02"),
+			StartYear:    int32(lp.StartYear),
+			EndYear:      int32(lp.EndYear),
+			Interactions: interactionInfos(DetectInteractions(withLuck), LuckPos),
+		}
+		info.StemGod, info.BranchGod = chart.gods(lp.Pillar)
+		for _, ap := range AnnualPillars(lp.StartYear, lp.EndYear) {
+			a := chart.annualPillarInfo(withLuck, ap)
+			a.LuckPillar = lp.Pillar.String()
+			info.Annual = append(info.Annual, a)
+		}
+		resp.LuckPillars = append(resp.LuckPillars, info)
+	}
+
+	if spanned {
+		for _, ap := range AnnualPillars(startYear, endYear) {
+			lp, ok := cycle.PillarOf(ap.Year)
+			if !ok {
+				resp.Annual = append(resp.Annual, chart.annualPillarInfo(natal, ap))
+				continue
+			}
+			a := chart.annualPillarInfo(append(natal[:len(natal):len(natal)], PlacedPillar{Position: LuckPos, Pillar: lp.Pillar}), ap)
+			a.LuckPillar = lp.Pillar.String()
+			resp.Annual = append(resp.Annual, a)
+		}
+	}
+	return resp, nil
+}
+
+// natalChart is what the luck and annual pillars are read against; hasDM and hasAge
+// are false when the uncertain birth time leaves the day or year pillar open.
+type natalChart struct {
+	dm        Stem
+	hasDM     bool
+	hasAge    bool
+	birthYear int
+}
+
+// gods returns the Ten Gods of p's stem and of its branch's main qi, or empty
+// strings without a day master.
+func (c natalChart) gods(p Pillar) (stem, branch string) {
+	if !c.hasDM {
+		return "", ""
+	}
+	return TenGodOf(c.dm, p.Stem).String(), TenGodOf(c.dm, branchHiddenStems[p.Branch][0]).String()
+}
+
+// annualPillarInfo describes the annual pillar ap against the chart pillars placed
+// (natal, plus the governing luck pillar when there is one).
+func (c natalChart) annualPillarInfo(placed []PlacedPillar, ap AnnualPillar) *pb.AnnualPillarInfo {
+	info := &pb.AnnualPillarInfo{
+		Year:   int32(ap.Year),
+		Pillar: ap.Pillar.String(),
+		Interactions: interactionInfos(DetectInteractions(
+			append(placed[:len(placed):len(placed)], PlacedPillar{Position: AnnualPos, Pillar: ap.Pillar})), AnnualPos),
+	}
+	if c.hasAge {
+		info.Age = int32(ap.Year - c.birthYear + 1)
+	}
+	info.StemGod, info.BranchGod = c.gods(ap.Pillar)
+	return info
+}
+
+// interactionInfos converts the interactions that involve position p.
+func interactionInfos(ins []Interaction, p Position) []*pb.InteractionInfo {
+	var out []*pb.InteractionInfo
+	for _, in := range ins {
+		if !in.Involves(p) {
+			continue
+		}
+		out = append(out, interactionInfo(in))
+	}
+	return out
+}
+
+func interactionInfo(in Interaction) *pb.InteractionInfo {
+	info := &pb.InteractionInfo{Kind: in.Kind.String(), Name: in.Name}
+	for _, p := range in.Positions {
+		info.Positions = append(info.Positions, p.String())
+	}
+	if in.HasElement {
+		info.Element = in.Element.String()
+	}
+	return info
+}
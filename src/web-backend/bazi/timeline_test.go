@@ -0,0 +1,107 @@
+package bazi
+
+import (
+	"context"
+	"testing"
+
+	pb "llyb-backend/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// 虚岁 count from the year of the year pillar: a birth before 立春 2000 has 己卯 for
+// its year, so it is 1 in 1999 and 2 in 2000 (庚辰).
+func TestTimelineAgeBeforeLichun(t *testing.T) {
+	resp, err := Timeline(context.Background(), &pb.TimelineRequest{
+		Birth: &pb.ReasoningRequest{
+			Gender:    pb.Gender_GENDER_MALE,
+			SolarDate: "2000-01-20",
+			BirthTime: "12:00",
+			Province:  "北京市",
+			City:      "北京市",
+			Longitude: proto.Float64(116.4),
+		},
+		StartYear: 1999,
+		EndYear:   2001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetCode() != 0 {
+		t.Fatalf("code %d: %s", resp.GetCode(), resp.GetMessage())
+	}
+	want := map[int32]int32{1999: 1, 2000: 2, 2001: 3}
+	for _, a := range resp.GetAnnual() {
+		if a.GetAge() != want[a.GetYear()] {
+			t.Errorf("%d %s: age %d, want %d", a.GetYear(), a.GetPillar(), a.GetAge(), want[a.GetYear()])
+		}
+	}
+	if len(resp.GetAnnual()) != len(want) {
+		t.Errorf("got %d annual pillars, want %d", len(resp.GetAnnual()), len(want))
+	}
+}
+
+// A range across 立春 leaves the year and month pillars open, so there is no luck
+// cycle to show; the day pillar is shared, so the ten gods are.
+func TestTimelineRangeAcrossLichun(t *testing.T) {
+	resp, err := Timeline(context.Background(), &pb.TimelineRequest{
+		Birth: &pb.ReasoningRequest{
+			Gender:        pb.Gender_GENDER_MALE,
+			SolarDate:     "2024-02-04",
+			BirthTimeFrom: "16:00",
+			BirthTimeTo:   "16:50",
+			Province:      "北京市",
+			City:          "北京市",
+			Longitude:     proto.Float64(116.4),
+		},
+		StartYear: 2024,
+		EndYear:   2025,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetCode() != 0 {
+		t.Fatalf("code %d: %s", resp.GetCode(), resp.GetMessage())
+	}
+	if resp.GetDirection() != "" || resp.GetJieName() != "" || len(resp.GetLuckPillars()) != 0 {
+		t.Errorf("got direction %q, 节 %q and %d luck pillars, want none", resp.GetDirection(), resp.GetJieName(), len(resp.GetLuckPillars()))
+	}
+	if len(resp.GetAnnual()) != 2 {
+		t.Fatalf("got %d annual pillars, want 2", len(resp.GetAnnual()))
+	}
+	for _, a := range resp.GetAnnual() {
+		if a.GetAge() != 0 || a.GetLuckPillar() != "" || a.GetStemGod() == "" {
+			t.Errorf("%d: age %d, luck pillar %q, stem god %q; want no age or luck pillar but a stem god",
+				a.GetYear(), a.GetAge(), a.GetLuckPillar(), a.GetStemGod())
+		}
+	}
+}
+
+// With the birth time unknown the day pillar may change at 子时, so the luck
+// pillars are shown without ten gods.
+func TestTimelineUnknownDayPillar(t *testing.T) {
+	resp, err := Timeline(context.Background(), &pb.TimelineRequest{
+		Birth: &pb.ReasoningRequest{
+			Gender:           pb.Gender_GENDER_FEMALE,
+			SolarDate:        "2024-03-10",
+			BirthTimeUnknown: true,
+			Province:         "北京市",
+			City:             "北京市",
+			Longitude:        proto.Float64(116.4),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetCode() != 0 {
+		t.Fatalf("code %d: %s", resp.GetCode(), resp.GetMessage())
+	}
+	if len(resp.GetLuckPillars()) == 0 {
+		t.Fatal("got no luck pillars")
+	}
+	for _, lp := range resp.GetLuckPillars() {
+		if lp.GetStemGod() != "" || lp.GetBranchGod() != "" {
+			t.Errorf("%s: ten gods %q %q, want none", lp.GetPillar(), lp.GetStemGod(), lp.GetBranchGod())
+		}
+	}
+}
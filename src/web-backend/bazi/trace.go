@@ -0,0 +1,264 @@
+package bazi
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "llyb-backend/proto"
+)
+
+// Computation trace: how Reasoning got from the input to the four pillars, step by
+// step. Each step has a code and string parameters for machines, and renders to
+// Chinese through traceTemplates.
+
+// TraceStep is one step of the derivation.
+type TraceStep struct {
+	Code   string
+	Params map[string]string
+}
+
+// traceTemplates renders steps in Chinese; "{name}" is replaced by the parameter.
+var traceTemplates = map[string]string{
+	"input":             "输入：{calendar}{date} {time}，{place}",
+	"uncertain_time":    "出生时间不确定（{from}–{to}），以下时刻均为区间的起止，随时刻而变的柱逐一列出",
+	"civil_time":        "当地民用时制：{rule}（UTC{offset}{dst_note}），出生时刻为世界时 {utc}",
+	"dst":               "扣除夏令时 {saving} 分钟，得标准时 {standard_time}",
+	"longitude":         "出生地经度 {longitude}°（来源：{source}），标准时子午线 {meridian}°，经度差修正 {correction} 分钟，得地方平太阳时 {mean_time}",
+	"longitude_error":   "未能确定出生地经度（{error}），以标准时 {standard_time} 代替真太阳时",
+	"equation_of_time":  "当日均时差 {eot} 分钟（真太阳时减平太阳时）",
+	"apparent_time":     "真太阳时 {apparent_time}",
+	"solar_terms":       "出生时刻位于{prev_term}（{prev_time}）与{next_term}（{next_time}）之间，属{month_branch}月",
+	"year_pillar":       "以立春（{lichun}）为岁首，出生于 {year} 年立春{side}，属 {bazi_year} 年；({bazi_year} − 4) mod 60 = {index}，年柱 {pillar}",
+	"month_pillar":      "年干{year_stem}，按「{rhyme}」寅月起{first}，顺推至{month_branch}月，月柱 {pillar}",
+	"day_pillar":        "真太阳时日期 {date}{boundary_note}，儒略日数 {jdn}，({jdn} + 49) mod 60 = {index}，日柱 {pillar}",
+	"hour_pillar":       "真太阳时 {time} 属{branch}时（{window}）；{hour_day_note}日干{day_stem}，按「{rhyme}」子时起{first}，顺推至{branch}时，时柱 {pillar}",
+	"pillar_candidates": "{position}柱随出生时刻而定：{candidates}",
+}
+
+// Text renders the step in Chinese.
+func (s TraceStep) Text() string {
+	tpl, ok := traceTemplates[s.Code]
+	if !ok {
+		return s.Code
+	}
+	keys := make([]string, 0, len(s.Params))
+	for k := range s.Params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, 2*len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, "{"+k+"}", s.Params[k])
+	}
+	return strings.NewReplacer(pairs...).Replace(tpl)
+}
+
+// 五虎遁 and 五鼠遁 rhymes, by year or day stem mod 5.
+var (
+	monthStemRhymes = [5]string{"甲己之年丙作首", "乙庚之岁戊为头", "丙辛必定寻庚起", "丁壬壬位顺行流", "戊癸何方发，甲寅之上好追求"}
+	hourStemRhymes  = [5]string{"甲己还加甲", "乙庚丙作初", "丙辛从戊起", "丁壬庚子居", "戊癸何方发，壬子是真途"}
+)
+
+// buildTrace explains the chart of b. For an uncertain birth time, times are given
+// as the span of the range, and a pillar that differs between the candidate charts
+// is listed per candidate instead of derived.
+func buildTrace(b *birth) []TraceStep {
+	var steps []TraceStep
+	add := func(code string, params map[string]string) {
+		steps = append(steps, TraceStep{Code: code, Params: params})
+	}
+	minutes := func(m float64) string { return strconv.FormatFloat(m, 'f', 2, 64) }
+	const clock = "2006-01-02 15:04:05"
+
+	// at formats f of the birth instant, or of the first and last instant of the range.
+	exact := b.timeMode == "exact" || len(b.candidates) == 0
+	at := func(f func(t time.Time) time.Time) string {
+		if exact {
+			return f(b.civil).Format(clock)
+		}
+		last := b.candidates[len(b.candidates)-1]
+		return f(b.candidates[0].CivilFrom).Format(clock) + " 至 " + f(last.CivilTo).Format(clock)
+	}
+	known := [4]bool{true, true, true, true}
+	if !exact {
+		_, known = commonPillars(b.candidates)
+	}
+
+	calendar := "公历 "
+	if b.inputCalendar == "lunar" {
+		calendar = "农历换算为公历 "
+	}
+	place := strings.TrimSpace(b.country + " " + b.province + b.city + b.district)
+	birthTime := b.birthTime
+	switch b.timeMode {
+	case "range":
+		birthTime = clockString(b.timeFrom) + "–" + clockString(b.timeTo)
+	case "unknown":
+		birthTime = "时间不详"
+	}
+	add("input", map[string]string{"calendar": calendar, "date": b.solarDate, "time": birthTime, "place": place})
+	if b.timeMode != "exact" {
+		add("uncertain_time", map[string]string{"from": clockString(b.timeFrom), "to": clockString(b.timeTo)})
+	}
+
+	dstNote := ""
+	if b.civilRule.DST {
+		dstNote = "，夏令时"
+	}
+	add("civil_time", map[string]string{
+		"rule":     b.civilRule.Name,
+		"offset":   b.civilRule.OffsetString(),
+		"dst":      strconv.FormatBool(b.civilRule.DST),
+		"dst_note": dstNote,
+		"utc":      at(time.Time.UTC),
+	})
+	stdZone := time.FixedZone("", int(b.civilRule.StandardOffset()/time.Second))
+	std := at(func(t time.Time) time.Time { return t.In(stdZone) })
+	if b.civilRule.DST {
+		add("dst", map[string]string{
+			"saving":        strconv.Itoa(int(b.civilRule.DSTSaving / time.Minute)),
+			"standard_time": std,
+		})
+	}
+
+	if b.lonOK() {
+		meridian := b.civilRule.StandardOffset().Hours() * 15
+		correction := 4 * (b.geo.Longitude - meridian)
+		meanTime := func(t time.Time) time.Time {
+			return t.UTC().Add(time.Duration(4 * b.geo.Longitude * float64(time.Minute)))
+		}
+		add("longitude", map[string]string{
+			"longitude":  strconv.FormatFloat(b.geo.Longitude, 'f', 4, 64),
+			"source":     b.geo.Provider,
+			"meridian":   strconv.FormatFloat(meridian, 'f', 1, 64),
+			"correction": minutes(correction),
+			"mean_time":  at(meanTime),
+		})
+		// The equation of time changes by well under a second within a day.
+		add("equation_of_time", map[string]string{"eot": minutes(equationOfTimeMinutes(b.civil))})
+		add("apparent_time", map[string]string{"apparent_time": at(func(t time.Time) time.Time { return b.solarTimeOf(t, b.civilRule) })})
+	} else {
+		add("longitude_error", map[string]string{"error": b.lonErr.Error(), "standard_time": std})
+	}
+
+	// Year and month follow the solar terms at the birth instant.
+	p := b.pillars
+	local := b.civil.Location()
+	if known[1] {
+		prev, next := JieAround(b.civil)
+		add("solar_terms", map[string]string{
+			"prev_term":    prev.Term.String(),
+			"prev_time":    prev.Time.In(local).Format(clock),
+			"next_term":    next.Term.String(),
+			"next_time":    next.Time.In(local).Format(clock),
+			"month_branch": p.Month.Branch.String(),
+		})
+	}
+	if known[0] {
+		lichun := SolarTerm(2).Time(b.trueSolar.Year())
+		baziYear, side := b.trueSolar.Year(), "之后"
+		if b.civil.Before(lichun) {
+			baziYear, side = baziYear-1, "之前"
+		}
+		add("year_pillar", map[string]string{
+			"lichun":    lichun.In(local).Format(clock),
+			"year":      strconv.Itoa(b.trueSolar.Year()),
+			"side":      side,
+			"bazi_year": strconv.Itoa(baziYear),
+			"index":     strconv.Itoa(p.Year.Index()),
+			"pillar":    p.Year.String(),
+		})
+	}
+	if known[0] && known[1] {
+		add("month_pillar", map[string]string{
+			"year_stem":    p.Year.Stem.String(),
+			"rhyme":        monthStemRhymes[int(p.Year.Stem)%5],
+			"first":        monthPillar(p.Year.Stem, 0).String(),
+			"month_branch": p.Month.Branch.String(),
+			"pillar":       p.Month.String(),
+		})
+	}
+
+	// Day and hour are read from the true solar clock.
+	ts := b.trueSolar
+	if known[2] {
+		jdn := julianDayNumber(ts.Year(), ts.Month(), ts.Day())
+		boundaryNote := ""
+		if ts.Hour() == 23 && b.dayBoundary == ZiInitial {
+			jdn++
+			boundaryNote = "，23 时后按" + b.dayBoundary.Label() + "计入次日"
+		}
+		add("day_pillar", map[string]string{
+			"date":          ts.Format("2006-01-02"),
+			"boundary_note": boundaryNote,
+			"jdn":           strconv.Itoa(jdn),
+			"index":         strconv.Itoa(mod(jdn+49, 60)),
+			"pillar":        p.Day.String(),
+		})
+	}
+	if known[2] && known[3] {
+		hourDay := p.Day
+		hourDayNote := ""
+		if ts.Hour() == 23 && b.dayBoundary == SplitZi {
+			hourDay = PillarFromIndex(p.Day.Index() + 1)
+			hourDayNote = "晚子时按" + b.dayBoundary.Label() + "取次日"
+		}
+		apparent := ts.Format("15:04")
+		if !exact {
+			last := b.candidates[len(b.candidates)-1]
+			apparent = b.candidates[0].SolarFrom.Format("15:04") + "–" + last.SolarTo.Format("15:04")
+		}
+		hb := p.Hour.Branch
+		from := mod(int(hb)*2-1, 24)
+		add("hour_pillar", map[string]string{
+			"time":          apparent,
+			"branch":        hb.String(),
+			"window":        fmt.Sprintf("%02d:00–%02d:00", from, (from+2)%24),
+			"hour_day_note": hourDayNote,
+			"day_stem":      hourDay.Stem.String(),
+			"rhyme":         hourStemRhymes[int(hourDay.Stem)%5],
+			"first":         Pillar{Stem: Stem(int(hourDay.Stem) % 5 * 2), Branch: 0}.String(),
+			"pillar":        p.Hour.String(),
+		})
+	}
+
+	// Pillars that depend on where in the range the birth falls.
+	for i, ok := range known {
+		if !ok {
+			add("pillar_candidates", map[string]string{
+				"position":   tracePositions[i],
+				"candidates": pillarCandidatesText(b.candidates, i),
+			})
+		}
+	}
+	return steps
+}
+
+var tracePositions = [4]string{"年", "月", "日", "时"}
+
+// pillarCandidatesText lists the i-th pillar (year, month, day, hour) across the
+// candidates with the clock windows it holds in, e.g. "22:00–22:41 乙亥；22:42–23:50 丙子".
+func pillarCandidatesText(cs []HourCandidate, i int) string {
+	var parts []string
+	for j := 0; j < len(cs); {
+		pl := cs[j].Pillars.pillars()[i]
+		k := j
+		for k+1 < len(cs) && cs[k+1].Pillars.pillars()[i] == pl {
+			k++
+		}
+		parts = append(parts, cs[j].CivilFrom.Format("15:04")+"–"+cs[k].CivilTo.Format("15:04")+" "+pl.String())
+		j = k + 1
+	}
+	return strings.Join(parts, "；")
+}
+
+func traceInfo(steps []TraceStep) []*pb.TraceStep {
+	out := make([]*pb.TraceStep, 0, len(steps))
+	for _, s := range steps {
+		out = append(out, &pb.TraceStep{Code: s.Code, Params: s.Params, Text: s.Text()})
+	}
+	return out
+}
@@ -0,0 +1,99 @@
+package bazi
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	pb "llyb-backend/proto"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// The trace derives the pillars on its own; its indices, starting pillars (五虎遁,
+// 五鼠遁) and results must agree with ComputePillars.
+func TestTraceMatchesComputePillars(t *testing.T) {
+	beijingBirth := func(date, clock string, db pb.DayBoundary) *pb.ReasoningRequest {
+		return &pb.ReasoningRequest{
+			Gender:      pb.Gender_GENDER_MALE,
+			SolarDate:   date,
+			BirthTime:   clock,
+			Province:    "北京市",
+			City:        "北京市",
+			Longitude:   proto.Float64(120),
+			DayBoundary: db,
+		}
+	}
+	lichun := beijingBirth("2024-02-04", "", 0)
+	lichun.BirthTime, lichun.BirthTimeFrom, lichun.BirthTimeTo = "", "16:00", "16:50"
+	london := &pb.ReasoningRequest{
+		Gender:    pb.Gender_GENDER_FEMALE,
+		SolarDate: "1944-06-01",
+		BirthTime: "12:00",
+		Country:   "英国",
+		TimeZone:  "Europe/London",
+		Longitude: proto.Float64(-0.1),
+	}
+
+	for _, tc := range []struct {
+		name   string
+		req    *pb.ReasoningRequest
+		saving string // the "dst" step's minutes; empty for no such step
+	}{
+		{"exact", beijingBirth("1995-05-15", "10:30", 0), ""},
+		{"23:30 zi_initial", beijingBirth("2001-03-10", "23:30", pb.DayBoundary_DAY_BOUNDARY_ZI_INITIAL), ""},
+		{"23:30 split_zi", beijingBirth("2001-03-10", "23:30", pb.DayBoundary_DAY_BOUNDARY_SPLIT_ZI), ""},
+		{"23:30 midnight", beijingBirth("2001-03-10", "23:30", pb.DayBoundary_DAY_BOUNDARY_MIDNIGHT), ""},
+		{"summer time 1988", beijingBirth("1988-07-01", "12:00", 0), "60"},
+		{"double summer time", london, "120"},
+		{"range across 立春", lichun, ""},
+	} {
+		b, err := resolveBirth(context.Background(), tc.req)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		want := ComputePillars(b.civil, b.trueSolar, b.dayBoundary)
+		steps := map[string]map[string]string{}
+		for _, s := range buildTrace(b) {
+			steps[s.Code] = s.Params
+		}
+
+		if got := steps["dst"]["saving"]; got != tc.saving {
+			t.Errorf("%s: DST saving %q, want %q", tc.name, got, tc.saving)
+		}
+		check := func(code string, p Pillar, first string, offset int) {
+			params, ok := steps[code]
+			if !ok {
+				return
+			}
+			if params["pillar"] != p.String() {
+				t.Errorf("%s: %s %s, want %s", tc.name, code, params["pillar"], p)
+			}
+			if idx, ok := params["index"]; ok && idx != strconv.Itoa(p.Index()) {
+				t.Errorf("%s: %s index %s, want %d", tc.name, code, idx, p.Index())
+			}
+			if first != "" {
+				f, ok := ParsePillar(params["first"])
+				if !ok || PillarFromIndex(f.Index()+offset) != p {
+					t.Errorf("%s: %s counted from %s, want it to reach %s", tc.name, code, params["first"], p)
+				}
+			}
+		}
+		check("year_pillar", want.Year, "", 0)
+		check("month_pillar", want.Month, "first", mod(int(want.Month.Branch)-2, 12))
+		check("day_pillar", want.Day, "", 0)
+		check("hour_pillar", want.Hour, "first", int(want.Hour.Branch))
+
+		// A pillar is derived when it and the pillar its stem is counted from are known.
+		known := [4]bool{true, true, true, true}
+		if len(b.candidates) > 0 {
+			_, known = commonPillars(b.candidates)
+		}
+		derived := [4]bool{known[0], known[0] && known[1], known[2], known[2] && known[3]}
+		for i, code := range []string{"year_pillar", "month_pillar", "day_pillar", "hour_pillar"} {
+			if _, ok := steps[code]; ok != derived[i] {
+				t.Errorf("%s: %s step present %v, want %v", tc.name, code, ok, derived[i])
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ go 1.22
 
 require (
 	github.com/go-sql-driver/mysql v1.8.1
+	golang.org/x/sync v0.1.0
 	google.golang.org/protobuf v1.33.0
 	trpc.group/trpc-go/trpc-go v1.0.3
 	trpc.group/trpc/trpc-protocol/pb/go/trpc v1.0.0
@@ -35,7 +36,6 @@ require (
 	go.uber.org/multierr v1.6.0 // indirect
 	go.uber.org/zap v1.24.0 // indirect
 	golang.org/x/net v0.17.0 // indirect
-	golang.org/x/sync v0.1.0 // indirect
 	golang.org/x/sys v0.13.0 // indirect
 	golang.org/x/text v0.13.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	thttp "trpc.group/trpc-go/trpc-go/http"
+)
+
+// HTTP caching for responses that never change for a given request (e.g. the
+// calendar): handlers set an ETag, and the response handler answers a matching
+// If-None-Match with 304 Not Modified instead of the body.
+
+// calendarMaxAge is how long clients may reuse a calendar month without revalidating.
+const calendarMaxAge = 7 * 24 * time.Hour
+
+// setCacheHeaders marks the response to ctx as cacheable under etag.
+func setCacheHeaders(ctx context.Context, etag string, maxAge time.Duration) {
+	rw := thttp.Response(ctx)
+	if rw == nil || etag == "" {
+		return
+	}
+	rw.Header().Set("ETag", etag)
+	rw.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge/time.Second)))
+}
+
+// notModifiedRspHandler replaces the HTTP codec's response handler: it writes the
+// body as the default one does, unless the request already holds the current ETag.
+func notModifiedRspHandler(w http.ResponseWriter, r *http.Request, rspBody []byte) error {
+	if etag := w.Header().Get("ETag"); etag != "" && etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	if len(rspBody) == 0 {
+		return nil
+	}
+	if _, err := w.Write(rspBody); err != nil {
+		return fmt.Errorf("http write response error: %s", err.Error())
+	}
+	return nil
+}
+
+// etagMatches implements If-None-Match's weak comparison against a list of tags.
+func etagMatches(header, etag string) bool {
+	for _, t := range strings.Split(header, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || strings.TrimPrefix(t, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
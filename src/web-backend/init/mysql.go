@@ -51,6 +51,21 @@ CREATE TABLE IF NOT EXISTS admin_account (
 	return err
 }
 
+func EnsureGeoCacheTable(ctx context.Context, db *sql.DB) error {
+	_, err := db.ExecContext(ctx, `
+CREATE TABLE IF NOT EXISTS geo_cache (
+  cache_key VARCHAR(191) NOT NULL,
+  longitude DOUBLE NOT NULL,
+  latitude DOUBLE NOT NULL,
+  provider VARCHAR(32) NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  PRIMARY KEY (cache_key),
+  KEY idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;`)
+	return err
+}
+
 func getenv(key, def string) string {
 	v := os.Getenv(key)
 	if v == "" {
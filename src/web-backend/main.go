@@ -6,6 +6,7 @@ import (
 	"time"
 
 	pb "llyb-backend/proto"
+	"llyb-backend/bazi"
 	"llyb-backend/chat"
 	appinit "llyb-backend/init"
 
@@ -28,12 +29,19 @@ func main() {
 			cancel()
 			log.Fatalf("mysql ensure schema failed: %v", err)
 		}
+		if err := appinit.EnsureGeoCacheTable(ctx, db); err != nil {
+			cancel()
+			log.Fatalf("mysql ensure schema failed: %v", err)
+		}
 		cancel()
 	}
+	bazi.ConfigureGeoCacheDB(db)
 
 	// Avoid CORS preflight during dev by letting clients send JSON with a simple
 	// Content-Type (text/plain). We still return JSON.
 	thttp.SetContentType("text/plain", codec.SerializationTypeJSON)
+	// Answer conditional requests for ETag-tagged responses with 304.
+	thttp.DefaultServerCodec.RspHandler = notModifiedRspHandler
 
 	corsFilter := func(ctx context.Context, req any, next filter.ServerHandleFunc) (any, error) {
 		rw := thttp.Response(ctx)
@@ -42,6 +50,7 @@ func main() {
 			rw.Header().Set("Access-Control-Allow-Origin", "*")
 			rw.Header().Set("Access-Control-Allow-Methods", "GET,POST,OPTIONS")
 			rw.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			rw.Header().Set("Access-Control-Expose-Headers", "ETag")
 		}
 		return next(ctx, req)
 	}
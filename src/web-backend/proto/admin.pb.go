@@ -72,6 +72,62 @@ func (Gender) EnumDescriptor() ([]byte, []int) {
 	return file_admin_proto_rawDescGZIP(), []int{0}
 }
 
+// Conventions for the 子时 hour straddling midnight.
+type DayBoundary int32
+
+const (
+	DayBoundary_DAY_BOUNDARY_UNSPECIFIED DayBoundary = 0
+	// 子初换日: the day pillar changes at 23:00.
+	DayBoundary_DAY_BOUNDARY_ZI_INITIAL DayBoundary = 1
+	// 早晚子时: the day pillar changes at 00:00; 晚子时 takes its hour stem from the next day.
+	DayBoundary_DAY_BOUNDARY_SPLIT_ZI DayBoundary = 2
+	// 子正换日: the day pillar changes at 00:00; 23:00 uses the current day's hour stem.
+	DayBoundary_DAY_BOUNDARY_MIDNIGHT DayBoundary = 3
+)
+
+// Enum value maps for DayBoundary.
+var (
+	DayBoundary_name = map[int32]string{
+		0: "DAY_BOUNDARY_UNSPECIFIED",
+		1: "DAY_BOUNDARY_ZI_INITIAL",
+		2: "DAY_BOUNDARY_SPLIT_ZI",
+		3: "DAY_BOUNDARY_MIDNIGHT",
+	}
+	DayBoundary_value = map[string]int32{
+		"DAY_BOUNDARY_UNSPECIFIED": 0,
+		"DAY_BOUNDARY_ZI_INITIAL":  1,
+		"DAY_BOUNDARY_SPLIT_ZI":    2,
+		"DAY_BOUNDARY_MIDNIGHT":    3,
+	}
+)
+
+func (x DayBoundary) Enum() *DayBoundary {
+	p := new(DayBoundary)
+	*p = x
+	return p
+}
+
+func (x DayBoundary) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DayBoundary) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (DayBoundary) Type() protoreflect.EnumType {
+	return &file_admin_proto_enumTypes[1]
+}
+
+func (x DayBoundary) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DayBoundary.Descriptor instead.
+func (DayBoundary) EnumDescriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{1}
+}
+
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -299,8 +355,36 @@ type ReasoningRequest struct {
 	SolarDate string `protobuf:"bytes,2,opt,name=solar_date,json=solarDate,proto3" json:"solar_date,omitempty"`
 	BirthTime string `protobuf:"bytes,3,opt,name=birth_time,json=birthTime,proto3" json:"birth_time,omitempty"`
 	// Location (human-readable).
-	Province      string `protobuf:"bytes,4,opt,name=province,proto3" json:"province,omitempty"`
-	City          string `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	Province string `protobuf:"bytes,4,opt,name=province,proto3" json:"province,omitempty"`
+	City     string `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	// Alternative to solar_date for users who only know their lunar (农历) birthday.
+	// - lunar_date: "YYYY-MM-DD" with lunar year/month/day numbers, e.g. "2023-02-03"
+	// - is_leap_month: true if the month is the leap month (闰月)
+	// Send either solar_date or lunar_date, not both.
+	LunarDate   string `protobuf:"bytes,6,opt,name=lunar_date,json=lunarDate,proto3" json:"lunar_date,omitempty"`
+	IsLeapMonth bool   `protobuf:"varint,7,opt,name=is_leap_month,json=isLeapMonth,proto3" json:"is_leap_month,omitempty"`
+	// Birth country as an ISO 3166-1 alpha-2 code, e.g. "CN", "US". Empty means "CN".
+	Country string `protobuf:"bytes,8,opt,name=country,proto3" json:"country,omitempty"`
+	// IANA time zone of the birth place, e.g. "America/New_York". Optional for mainland
+	// China (historical China rules apply) and single-zone countries; required otherwise.
+	TimeZone string `protobuf:"bytes,9,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// Optional county/district, e.g. "道里区"; improves the longitude in large prefectures.
+	District string `protobuf:"bytes,10,opt,name=district,proto3" json:"district,omitempty"`
+	// Optional explicit coordinates in degrees (East/North positive). When longitude is
+	// set, geocoding is skipped entirely; latitude is informational.
+	Longitude *float64 `protobuf:"fixed64,11,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	Latitude  *float64 `protobuf:"fixed64,12,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	// How 23:00–24:00 splits the day; unspecified uses the server default
+	// (BAZI_DAY_BOUNDARY).
+	DayBoundary DayBoundary `protobuf:"varint,13,opt,name=day_boundary,json=dayBoundary,proto3,enum=trpc.llyb.backend.admin.DayBoundary" json:"day_boundary,omitempty"`
+	// Uncertain birth time, instead of birth_time: a range "HH:mm".."HH:mm" within the
+	// birth date (e.g. "06:00".."12:00" for "morning"), or birth_time_unknown for the
+	// whole day. The result then lists the candidate hour pillars.
+	BirthTimeFrom    string `protobuf:"bytes,14,opt,name=birth_time_from,json=birthTimeFrom,proto3" json:"birth_time_from,omitempty"`
+	BirthTimeTo      string `protobuf:"bytes,15,opt,name=birth_time_to,json=birthTimeTo,proto3" json:"birth_time_to,omitempty"`
+	BirthTimeUnknown bool   `protobuf:"varint,16,opt,name=birth_time_unknown,json=birthTimeUnknown,proto3" json:"birth_time_unknown,omitempty"`
+	// Return the step-by-step derivation of the chart in ReasoningResponse.trace.
+	Trace         bool `protobuf:"varint,17,opt,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -370,14 +454,114 @@ func (x *ReasoningRequest) GetCity() string {
 	return ""
 }
 
+func (x *ReasoningRequest) GetLunarDate() string {
+	if x != nil {
+		return x.LunarDate
+	}
+	return ""
+}
+
+func (x *ReasoningRequest) GetIsLeapMonth() bool {
+	if x != nil {
+		return x.IsLeapMonth
+	}
+	return false
+}
+
+func (x *ReasoningRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ReasoningRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *ReasoningRequest) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *ReasoningRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *ReasoningRequest) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *ReasoningRequest) GetDayBoundary() DayBoundary {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return DayBoundary_DAY_BOUNDARY_UNSPECIFIED
+}
+
+func (x *ReasoningRequest) GetBirthTimeFrom() string {
+	if x != nil {
+		return x.BirthTimeFrom
+	}
+	return ""
+}
+
+func (x *ReasoningRequest) GetBirthTimeTo() string {
+	if x != nil {
+		return x.BirthTimeTo
+	}
+	return ""
+}
+
+func (x *ReasoningRequest) GetBirthTimeUnknown() bool {
+	if x != nil {
+		return x.BirthTimeUnknown
+	}
+	return false
+}
+
+func (x *ReasoningRequest) GetTrace() bool {
+	if x != nil {
+		return x.Trace
+	}
+	return false
+}
+
 type ReasoningResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 0 means success; non-zero indicates an error.
 	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// Placeholder for later backend output.
-	// Keep it flexible until the data contract is finalized.
-	ResultJson    string `protobuf:"bytes,3,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	// The result as free-form JSON. Deprecated: read the typed fields below; this is
+	// kept for one deprecation period so that older clients keep working.
+	//
+	// Deprecated: Marked as deprecated in admin.proto.
+	ResultJson string `protobuf:"bytes,3,opt,name=result_json,json=resultJson,proto3" json:"result_json,omitempty"`
+	// The birth inputs as understood by the server.
+	Birth *BirthInfo `protobuf:"bytes,4,opt,name=birth,proto3" json:"birth,omitempty"`
+	// Civil time rule and true solar time correction.
+	SolarTime *SolarTimeInfo `protobuf:"bytes,5,opt,name=solar_time,json=solarTime,proto3" json:"solar_time,omitempty"`
+	Chart     *Chart         `protobuf:"bytes,6,opt,name=chart,proto3" json:"chart,omitempty"`
+	// Element balance and day master strength; unset when any pillar is uncertain.
+	FiveElements *ElementAnalysisInfo `protobuf:"bytes,7,opt,name=five_elements,json=fiveElements,proto3" json:"five_elements,omitempty"`
+	DayMaster    *DayMasterInfo       `protobuf:"bytes,8,opt,name=day_master,json=dayMaster,proto3" json:"day_master,omitempty"`
+	// Uncertain birth time only: the possible charts with the windows they cover.
+	HourCandidates []*HourCandidateInfo `protobuf:"bytes,9,rep,name=hour_candidates,json=hourCandidates,proto3" json:"hour_candidates,omitempty"`
+	// Uncertain birth time only: result_json keys withheld or reduced for it.
+	HourDependent []string `protobuf:"bytes,10,rep,name=hour_dependent,json=hourDependent,proto3" json:"hour_dependent,omitempty"`
+	// Requested with trace: how the chart was derived, step by step.
+	Trace         []*TraceStep `protobuf:"bytes,11,rep,name=trace,proto3" json:"trace,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -426,6 +610,7 @@ func (x *ReasoningResponse) GetMessage() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in admin.proto.
 func (x *ReasoningResponse) GetResultJson() string {
 	if x != nil {
 		return x.ResultJson
@@ -433,46 +618,4808 @@ func (x *ReasoningResponse) GetResultJson() string {
 	return ""
 }
 
-var File_admin_proto protoreflect.FileDescriptor
+func (x *ReasoningResponse) GetBirth() *BirthInfo {
+	if x != nil {
+		return x.Birth
+	}
+	return nil
+}
 
-const file_admin_proto_rawDesc = "" +
-	"\n" +
-	"\vadmin.proto\x12\x17trpc.llyb.backend.admin\x1a\x1dtrpc/proto/trpc_options.proto\"F\n" +
-	"\fLoginRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"9\n" +
-	"\rLoginResponse\x12\x0e\n" +
-	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"I\n" +
-	"\x0fRegisterRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"_\n" +
-	"\x10RegisterResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"\xb9\x01\n" +
-	"\x10ReasoningRequest\x127\n" +
-	"\x06gender\x18\x01 \x01(\x0e2\x1f.trpc.llyb.backend.admin.GenderR\x06gender\x12\x1d\n" +
-	"\n" +
-	"solar_date\x18\x02 \x01(\tR\tsolarDate\x12\x1d\n" +
-	"\n" +
-	"birth_time\x18\x03 \x01(\tR\tbirthTime\x12\x1a\n" +
-	"\bprovince\x18\x04 \x01(\tR\bprovince\x12\x12\n" +
-	"\x04city\x18\x05 \x01(\tR\x04city\"b\n" +
-	"\x11ReasoningResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1f\n" +
-	"\vresult_json\x18\x03 \x01(\tR\n" +
-	"resultJson*D\n" +
-	"\x06Gender\x12\x16\n" +
-	"\x12GENDER_UNSPECIFIED\x10\x00\x12\x0f\n" +
-	"\vGENDER_MALE\x10\x01\x12\x11\n" +
-	"\rGENDER_FEMALE\x10\x022\xe1\x02\n" +
-	"\x05Admin\x12h\n" +
-	"\x05Login\x12%.trpc.llyb.backend.admin.LoginRequest\x1a&.trpc.llyb.backend.admin.LoginResponse\"\x10\x8a\xb5\x18\f/admin/login\x12t\n" +
-	"\bRegister\x12(.trpc.llyb.backend.admin.RegisterRequest\x1a).trpc.llyb.backend.admin.RegisterResponse\"\x13\x8a\xb5\x18\x0f/admin/register\x12x\n" +
-	"\tReasoning\x12).trpc.llyb.backend.admin.ReasoningRequest\x1a*.trpc.llyb.backend.admin.ReasoningResponse\"\x14\x8a\xb5\x18\x10/admin/reasoningB\x1aZ\x18llyb-backend/proto;protob\x06proto3"
+func (x *ReasoningResponse) GetSolarTime() *SolarTimeInfo {
+	if x != nil {
+		return x.SolarTime
+	}
+	return nil
+}
+
+func (x *ReasoningResponse) GetChart() *Chart {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+func (x *ReasoningResponse) GetFiveElements() *ElementAnalysisInfo {
+	if x != nil {
+		return x.FiveElements
+	}
+	return nil
+}
+
+func (x *ReasoningResponse) GetDayMaster() *DayMasterInfo {
+	if x != nil {
+		return x.DayMaster
+	}
+	return nil
+}
+
+func (x *ReasoningResponse) GetHourCandidates() []*HourCandidateInfo {
+	if x != nil {
+		return x.HourCandidates
+	}
+	return nil
+}
+
+func (x *ReasoningResponse) GetHourDependent() []string {
+	if x != nil {
+		return x.HourDependent
+	}
+	return nil
+}
+
+func (x *ReasoningResponse) GetTrace() []*TraceStep {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+type TraceStep struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Machine-readable step: "input", "uncertain_time", "civil_time", "dst",
+	// "longitude", "longitude_error", "equation_of_time", "apparent_time",
+	// "solar_terms", "year_pillar", "month_pillar", "day_pillar", "hour_pillar" or
+	// "pillar_candidates" (a pillar that differs within an uncertain birth time range).
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// The step's values, e.g. {"eot": "2.87"} (minutes), keyed as in text's template.
+	Params map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The step rendered in Chinese.
+	Text          string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TraceStep) Reset() {
+	*x = TraceStep{}
+	mi := &file_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TraceStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraceStep) ProtoMessage() {}
+
+func (x *TraceStep) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraceStep.ProtoReflect.Descriptor instead.
+func (*TraceStep) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TraceStep) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *TraceStep) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *TraceStep) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type LunarDateInfo struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Year        int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month       int32                  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day         int32                  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	IsLeapMonth bool                   `protobuf:"varint,4,opt,name=is_leap_month,json=isLeapMonth,proto3" json:"is_leap_month,omitempty"`
+	// e.g. "1990年四月初七".
+	Text          string `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LunarDateInfo) Reset() {
+	*x = LunarDateInfo{}
+	mi := &file_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LunarDateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LunarDateInfo) ProtoMessage() {}
+
+func (x *LunarDateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LunarDateInfo.ProtoReflect.Descriptor instead.
+func (*LunarDateInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *LunarDateInfo) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *LunarDateInfo) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *LunarDateInfo) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *LunarDateInfo) GetIsLeapMonth() bool {
+	if x != nil {
+		return x.IsLeapMonth
+	}
+	return false
+}
+
+func (x *LunarDateInfo) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type BirthInfo struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Gender Gender                 `protobuf:"varint,1,opt,name=gender,proto3,enum=trpc.llyb.backend.admin.Gender" json:"gender,omitempty"`
+	// ISO 3166-1 alpha-2 code, e.g. "CN".
+	Country string `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	// IANA name; empty when China's historical rules applied.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// "solar" or "lunar": the calendar the date was entered in.
+	InputCalendar string `protobuf:"bytes,4,opt,name=input_calendar,json=inputCalendar,proto3" json:"input_calendar,omitempty"`
+	// Gregorian date "YYYY-MM-DD" and its lunar date (unset outside 1900..2100).
+	SolarDate string         `protobuf:"bytes,5,opt,name=solar_date,json=solarDate,proto3" json:"solar_date,omitempty"`
+	LunarDate *LunarDateInfo `protobuf:"bytes,6,opt,name=lunar_date,json=lunarDate,proto3" json:"lunar_date,omitempty"`
+	// "HH:mm"; the middle of the range when the time is uncertain.
+	BirthTime string `protobuf:"bytes,7,opt,name=birth_time,json=birthTime,proto3" json:"birth_time,omitempty"`
+	Province  string `protobuf:"bytes,8,opt,name=province,proto3" json:"province,omitempty"`
+	City      string `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	District  string `protobuf:"bytes,10,opt,name=district,proto3" json:"district,omitempty"`
+	// "exact", "range" or "unknown"; birth_time_from/to bound an uncertain time.
+	TimeMode      string `protobuf:"bytes,11,opt,name=time_mode,json=timeMode,proto3" json:"time_mode,omitempty"`
+	BirthTimeFrom string `protobuf:"bytes,12,opt,name=birth_time_from,json=birthTimeFrom,proto3" json:"birth_time_from,omitempty"`
+	BirthTimeTo   string `protobuf:"bytes,13,opt,name=birth_time_to,json=birthTimeTo,proto3" json:"birth_time_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BirthInfo) Reset() {
+	*x = BirthInfo{}
+	mi := &file_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BirthInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BirthInfo) ProtoMessage() {}
+
+func (x *BirthInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BirthInfo.ProtoReflect.Descriptor instead.
+func (*BirthInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BirthInfo) GetGender() Gender {
+	if x != nil {
+		return x.Gender
+	}
+	return Gender_GENDER_UNSPECIFIED
+}
+
+func (x *BirthInfo) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetInputCalendar() string {
+	if x != nil {
+		return x.InputCalendar
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetSolarDate() string {
+	if x != nil {
+		return x.SolarDate
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetLunarDate() *LunarDateInfo {
+	if x != nil {
+		return x.LunarDate
+	}
+	return nil
+}
+
+func (x *BirthInfo) GetBirthTime() string {
+	if x != nil {
+		return x.BirthTime
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetProvince() string {
+	if x != nil {
+		return x.Province
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetDistrict() string {
+	if x != nil {
+		return x.District
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetTimeMode() string {
+	if x != nil {
+		return x.TimeMode
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetBirthTimeFrom() string {
+	if x != nil {
+		return x.BirthTimeFrom
+	}
+	return ""
+}
+
+func (x *BirthInfo) GetBirthTimeTo() string {
+	if x != nil {
+		return x.BirthTimeTo
+	}
+	return ""
+}
+
+type SolarTimeInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Civil time rule of the birth place and date, e.g. "北京夏令时", "+09:00".
+	CivilRule string `protobuf:"bytes,1,opt,name=civil_rule,json=civilRule,proto3" json:"civil_rule,omitempty"`
+	UtcOffset string `protobuf:"bytes,2,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	Dst       bool   `protobuf:"varint,3,opt,name=dst,proto3" json:"dst,omitempty"`
+	// Longitude used for the correction, where it came from ("input", "gazetteer" or a
+	// geocoder) and at which level ("input", "district" or "city"); unset on failure.
+	Longitude       *float64 `protobuf:"fixed64,4,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	LongitudeSource string   `protobuf:"bytes,5,opt,name=longitude_source,json=longitudeSource,proto3" json:"longitude_source,omitempty"`
+	LongitudeLevel  string   `protobuf:"bytes,6,opt,name=longitude_level,json=longitudeLevel,proto3" json:"longitude_level,omitempty"`
+	// "YYYY/MM/DD HH:mm"; zone standard time if the longitude is unknown, empty when the
+	// birth time is uncertain.
+	TrueSolarTime string `protobuf:"bytes,7,opt,name=true_solar_time,json=trueSolarTime,proto3" json:"true_solar_time,omitempty"`
+	// Why the longitude could not be resolved, if it could not.
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolarTimeInfo) Reset() {
+	*x = SolarTimeInfo{}
+	mi := &file_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolarTimeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolarTimeInfo) ProtoMessage() {}
+
+func (x *SolarTimeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolarTimeInfo.ProtoReflect.Descriptor instead.
+func (*SolarTimeInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SolarTimeInfo) GetCivilRule() string {
+	if x != nil {
+		return x.CivilRule
+	}
+	return ""
+}
+
+func (x *SolarTimeInfo) GetUtcOffset() string {
+	if x != nil {
+		return x.UtcOffset
+	}
+	return ""
+}
+
+func (x *SolarTimeInfo) GetDst() bool {
+	if x != nil {
+		return x.Dst
+	}
+	return false
+}
+
+func (x *SolarTimeInfo) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *SolarTimeInfo) GetLongitudeSource() string {
+	if x != nil {
+		return x.LongitudeSource
+	}
+	return ""
+}
+
+func (x *SolarTimeInfo) GetLongitudeLevel() string {
+	if x != nil {
+		return x.LongitudeLevel
+	}
+	return ""
+}
+
+func (x *SolarTimeInfo) GetTrueSolarTime() string {
+	if x != nil {
+		return x.TrueSolarTime
+	}
+	return ""
+}
+
+func (x *SolarTimeInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type HiddenStemInfo struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Stem    string                 `protobuf:"bytes,1,opt,name=stem,proto3" json:"stem,omitempty"`
+	Element string                 `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	// 本气, 中气 or 余气.
+	Qi string `protobuf:"bytes,3,opt,name=qi,proto3" json:"qi,omitempty"`
+	// Ten God relative to the day master.
+	God           string `protobuf:"bytes,4,opt,name=god,proto3" json:"god,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HiddenStemInfo) Reset() {
+	*x = HiddenStemInfo{}
+	mi := &file_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HiddenStemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HiddenStemInfo) ProtoMessage() {}
+
+func (x *HiddenStemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HiddenStemInfo.ProtoReflect.Descriptor instead.
+func (*HiddenStemInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HiddenStemInfo) GetStem() string {
+	if x != nil {
+		return x.Stem
+	}
+	return ""
+}
+
+func (x *HiddenStemInfo) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *HiddenStemInfo) GetQi() string {
+	if x != nil {
+		return x.Qi
+	}
+	return ""
+}
+
+func (x *HiddenStemInfo) GetGod() string {
+	if x != nil {
+		return x.God
+	}
+	return ""
+}
+
+type StarInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "天乙贵人", with the rule that triggered it, e.g. "日干甲见丑".
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rule          string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StarInfo) Reset() {
+	*x = StarInfo{}
+	mi := &file_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StarInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StarInfo) ProtoMessage() {}
+
+func (x *StarInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StarInfo.ProtoReflect.Descriptor instead.
+func (*StarInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StarInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StarInfo) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+type Pillar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "year", "month", "day" or "hour".
+	Position string `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	// e.g. "庚午". Empty, like everything below, when the pillar is uncertain.
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Uncertain     bool   `protobuf:"varint,3,opt,name=uncertain,proto3" json:"uncertain,omitempty"`
+	Stem          string `protobuf:"bytes,4,opt,name=stem,proto3" json:"stem,omitempty"`
+	Branch        string `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+	StemElement   string `protobuf:"bytes,6,opt,name=stem_element,json=stemElement,proto3" json:"stem_element,omitempty"`
+	BranchElement string `protobuf:"bytes,7,opt,name=branch_element,json=branchElement,proto3" json:"branch_element,omitempty"`
+	// Ten God of the stem, or "日主" for the day stem; hidden stems of the branch.
+	StemGod string            `protobuf:"bytes,8,opt,name=stem_god,json=stemGod,proto3" json:"stem_god,omitempty"`
+	Hidden  []*HiddenStemInfo `protobuf:"bytes,9,rep,name=hidden,proto3" json:"hidden,omitempty"`
+	// 纳音, 十二长生 of the day master (星运) and of the pillar's own stem (自坐).
+	NaYin     string `protobuf:"bytes,10,opt,name=na_yin,json=naYin,proto3" json:"na_yin,omitempty"`
+	Stage     string `protobuf:"bytes,11,opt,name=stage,proto3" json:"stage,omitempty"`
+	SelfStage string `protobuf:"bytes,12,opt,name=self_stage,json=selfStage,proto3" json:"self_stage,omitempty"`
+	// Whether the branch is void (空亡) by the day or by the year pillar.
+	Void          bool        `protobuf:"varint,13,opt,name=void,proto3" json:"void,omitempty"`
+	YearVoid      bool        `protobuf:"varint,14,opt,name=year_void,json=yearVoid,proto3" json:"year_void,omitempty"`
+	Stars         []*StarInfo `protobuf:"bytes,15,rep,name=stars,proto3" json:"stars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pillar) Reset() {
+	*x = Pillar{}
+	mi := &file_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pillar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pillar) ProtoMessage() {}
+
+func (x *Pillar) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pillar.ProtoReflect.Descriptor instead.
+func (*Pillar) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Pillar) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *Pillar) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Pillar) GetUncertain() bool {
+	if x != nil {
+		return x.Uncertain
+	}
+	return false
+}
+
+func (x *Pillar) GetStem() string {
+	if x != nil {
+		return x.Stem
+	}
+	return ""
+}
+
+func (x *Pillar) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *Pillar) GetStemElement() string {
+	if x != nil {
+		return x.StemElement
+	}
+	return ""
+}
+
+func (x *Pillar) GetBranchElement() string {
+	if x != nil {
+		return x.BranchElement
+	}
+	return ""
+}
+
+func (x *Pillar) GetStemGod() string {
+	if x != nil {
+		return x.StemGod
+	}
+	return ""
+}
+
+func (x *Pillar) GetHidden() []*HiddenStemInfo {
+	if x != nil {
+		return x.Hidden
+	}
+	return nil
+}
+
+func (x *Pillar) GetNaYin() string {
+	if x != nil {
+		return x.NaYin
+	}
+	return ""
+}
+
+func (x *Pillar) GetStage() string {
+	if x != nil {
+		return x.Stage
+	}
+	return ""
+}
+
+func (x *Pillar) GetSelfStage() string {
+	if x != nil {
+		return x.SelfStage
+	}
+	return ""
+}
+
+func (x *Pillar) GetVoid() bool {
+	if x != nil {
+		return x.Void
+	}
+	return false
+}
+
+func (x *Pillar) GetYearVoid() bool {
+	if x != nil {
+		return x.YearVoid
+	}
+	return false
+}
+
+func (x *Pillar) GetStars() []*StarInfo {
+	if x != nil {
+		return x.Stars
+	}
+	return nil
+}
+
+type Chart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Year, month, day and hour, in that order.
+	Pillars []*Pillar `protobuf:"bytes,1,rep,name=pillars,proto3" json:"pillars,omitempty"`
+	// "年柱 月柱 日柱 时柱"; "？？" for uncertain pillars.
+	Bazi string `protobuf:"bytes,2,opt,name=bazi,proto3" json:"bazi,omitempty"`
+	// 子时 convention, e.g. "zi_initial", with its label, and whether the birth falls in
+	// 23:00–24:00 where conventions differ.
+	DayBoundary      string `protobuf:"bytes,3,opt,name=day_boundary,json=dayBoundary,proto3" json:"day_boundary,omitempty"`
+	DayBoundaryLabel string `protobuf:"bytes,4,opt,name=day_boundary_label,json=dayBoundaryLabel,proto3" json:"day_boundary_label,omitempty"`
+	LateZi           bool   `protobuf:"varint,5,opt,name=late_zi,json=lateZi,proto3" json:"late_zi,omitempty"`
+	// Relations between the pillars.
+	Interactions []*InteractionInfo `protobuf:"bytes,6,rep,name=interactions,proto3" json:"interactions,omitempty"`
+	// 空亡 branches by the day and by the year pillar, e.g. "戌亥".
+	DayVoid       string `protobuf:"bytes,7,opt,name=day_void,json=dayVoid,proto3" json:"day_void,omitempty"`
+	YearVoid      string `protobuf:"bytes,8,opt,name=year_void,json=yearVoid,proto3" json:"year_void,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chart) Reset() {
+	*x = Chart{}
+	mi := &file_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chart) ProtoMessage() {}
+
+func (x *Chart) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chart.ProtoReflect.Descriptor instead.
+func (*Chart) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Chart) GetPillars() []*Pillar {
+	if x != nil {
+		return x.Pillars
+	}
+	return nil
+}
+
+func (x *Chart) GetBazi() string {
+	if x != nil {
+		return x.Bazi
+	}
+	return ""
+}
+
+func (x *Chart) GetDayBoundary() string {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return ""
+}
+
+func (x *Chart) GetDayBoundaryLabel() string {
+	if x != nil {
+		return x.DayBoundaryLabel
+	}
+	return ""
+}
+
+func (x *Chart) GetLateZi() bool {
+	if x != nil {
+		return x.LateZi
+	}
+	return false
+}
+
+func (x *Chart) GetInteractions() []*InteractionInfo {
+	if x != nil {
+		return x.Interactions
+	}
+	return nil
+}
+
+func (x *Chart) GetDayVoid() string {
+	if x != nil {
+		return x.DayVoid
+	}
+	return ""
+}
+
+func (x *Chart) GetYearVoid() string {
+	if x != nil {
+		return x.YearVoid
+	}
+	return ""
+}
+
+type ElementScores struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 木, 火, 土, 金 or 水.
+	Element string  `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"`
+	Score   float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Percent float64 `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`
+	// Visible characters (of 4 stems + 4 branches).
+	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// 旺, 相, 休, 囚 or 死 in the birth month.
+	State         string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElementScores) Reset() {
+	*x = ElementScores{}
+	mi := &file_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElementScores) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElementScores) ProtoMessage() {}
+
+func (x *ElementScores) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElementScores.ProtoReflect.Descriptor instead.
+func (*ElementScores) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ElementScores) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *ElementScores) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ElementScores) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *ElementScores) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ElementScores) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ElementAnalysisInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Elements []*ElementScores       `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
+	Missing  []string               `protobuf:"bytes,2,rep,name=missing,proto3" json:"missing,omitempty"`
+	// Element in command (当令).
+	Season        string `protobuf:"bytes,3,opt,name=season,proto3" json:"season,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ElementAnalysisInfo) Reset() {
+	*x = ElementAnalysisInfo{}
+	mi := &file_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElementAnalysisInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElementAnalysisInfo) ProtoMessage() {}
+
+func (x *ElementAnalysisInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElementAnalysisInfo.ProtoReflect.Descriptor instead.
+func (*ElementAnalysisInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ElementAnalysisInfo) GetElements() []*ElementScores {
+	if x != nil {
+		return x.Elements
+	}
+	return nil
+}
+
+func (x *ElementAnalysisInfo) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *ElementAnalysisInfo) GetSeason() string {
+	if x != nil {
+		return x.Season
+	}
+	return ""
+}
+
+type DayMasterInfo struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Stem    string                 `protobuf:"bytes,1,opt,name=stem,proto3" json:"stem,omitempty"`
+	Element string                 `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	// 极弱, 偏弱, 中和, 偏强 or 极强.
+	Strength      string   `protobuf:"bytes,3,opt,name=strength,proto3" json:"strength,omitempty"`
+	SupportRatio  float64  `protobuf:"fixed64,4,opt,name=support_ratio,json=supportRatio,proto3" json:"support_ratio,omitempty"`
+	DeLing        bool     `protobuf:"varint,5,opt,name=de_ling,json=deLing,proto3" json:"de_ling,omitempty"`
+	DeDi          bool     `protobuf:"varint,6,opt,name=de_di,json=deDi,proto3" json:"de_di,omitempty"`
+	DeShi         bool     `protobuf:"varint,7,opt,name=de_shi,json=deShi,proto3" json:"de_shi,omitempty"`
+	Favourable    []string `protobuf:"bytes,8,rep,name=favourable,proto3" json:"favourable,omitempty"`
+	Unfavourable  []string `protobuf:"bytes,9,rep,name=unfavourable,proto3" json:"unfavourable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayMasterInfo) Reset() {
+	*x = DayMasterInfo{}
+	mi := &file_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayMasterInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayMasterInfo) ProtoMessage() {}
+
+func (x *DayMasterInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayMasterInfo.ProtoReflect.Descriptor instead.
+func (*DayMasterInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DayMasterInfo) GetStem() string {
+	if x != nil {
+		return x.Stem
+	}
+	return ""
+}
+
+func (x *DayMasterInfo) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *DayMasterInfo) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *DayMasterInfo) GetSupportRatio() float64 {
+	if x != nil {
+		return x.SupportRatio
+	}
+	return 0
+}
+
+func (x *DayMasterInfo) GetDeLing() bool {
+	if x != nil {
+		return x.DeLing
+	}
+	return false
+}
+
+func (x *DayMasterInfo) GetDeDi() bool {
+	if x != nil {
+		return x.DeDi
+	}
+	return false
+}
+
+func (x *DayMasterInfo) GetDeShi() bool {
+	if x != nil {
+		return x.DeShi
+	}
+	return false
+}
+
+func (x *DayMasterInfo) GetFavourable() []string {
+	if x != nil {
+		return x.Favourable
+	}
+	return nil
+}
+
+func (x *DayMasterInfo) GetUnfavourable() []string {
+	if x != nil {
+		return x.Unfavourable
+	}
+	return nil
+}
+
+type HourCandidateInfo struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Bazi       string                 `protobuf:"bytes,1,opt,name=bazi,proto3" json:"bazi,omitempty"`
+	HourPillar string                 `protobuf:"bytes,2,opt,name=hour_pillar,json=hourPillar,proto3" json:"hour_pillar,omitempty"`
+	// Clock time "HH:mm" and true solar time "YYYY/MM/DD HH:mm" of the window's first
+	// and last minute.
+	CivilFrom     string `protobuf:"bytes,3,opt,name=civil_from,json=civilFrom,proto3" json:"civil_from,omitempty"`
+	CivilTo       string `protobuf:"bytes,4,opt,name=civil_to,json=civilTo,proto3" json:"civil_to,omitempty"`
+	TrueSolarFrom string `protobuf:"bytes,5,opt,name=true_solar_from,json=trueSolarFrom,proto3" json:"true_solar_from,omitempty"`
+	TrueSolarTo   string `protobuf:"bytes,6,opt,name=true_solar_to,json=trueSolarTo,proto3" json:"true_solar_to,omitempty"`
+	// Day master strength and favourable elements under this chart.
+	Strength      string   `protobuf:"bytes,7,opt,name=strength,proto3" json:"strength,omitempty"`
+	Favourable    []string `protobuf:"bytes,8,rep,name=favourable,proto3" json:"favourable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourCandidateInfo) Reset() {
+	*x = HourCandidateInfo{}
+	mi := &file_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourCandidateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourCandidateInfo) ProtoMessage() {}
+
+func (x *HourCandidateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourCandidateInfo.ProtoReflect.Descriptor instead.
+func (*HourCandidateInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *HourCandidateInfo) GetBazi() string {
+	if x != nil {
+		return x.Bazi
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetHourPillar() string {
+	if x != nil {
+		return x.HourPillar
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetCivilFrom() string {
+	if x != nil {
+		return x.CivilFrom
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetCivilTo() string {
+	if x != nil {
+		return x.CivilTo
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetTrueSolarFrom() string {
+	if x != nil {
+		return x.TrueSolarFrom
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetTrueSolarTo() string {
+	if x != nil {
+		return x.TrueSolarTo
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *HourCandidateInfo) GetFavourable() []string {
+	if x != nil {
+		return x.Favourable
+	}
+	return nil
+}
+
+type SolarTermsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Gregorian year, e.g. 2024. Supported range: 1000..3000.
+	Year          int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolarTermsRequest) Reset() {
+	*x = SolarTermsRequest{}
+	mi := &file_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolarTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolarTermsRequest) ProtoMessage() {}
+
+func (x *SolarTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolarTermsRequest.ProtoReflect.Descriptor instead.
+func (*SolarTermsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SolarTermsRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type SolarTermInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 = 小寒 ... 23 = 冬至 (calendar order within the Gregorian year).
+	Index int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Name  string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Apparent solar longitude that defines the term.
+	LongitudeDeg float64 `protobuf:"fixed64,3,opt,name=longitude_deg,json=longitudeDeg,proto3" json:"longitude_deg,omitempty"`
+	// true for 节 (solar month boundary), false for 中气.
+	IsJie bool `protobuf:"varint,4,opt,name=is_jie,json=isJie,proto3" json:"is_jie,omitempty"`
+	// Beijing time "YYYY-MM-DD HH:mm:ss".
+	Time          string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	Unix          int64  `protobuf:"varint,6,opt,name=unix,proto3" json:"unix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolarTermInfo) Reset() {
+	*x = SolarTermInfo{}
+	mi := &file_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolarTermInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolarTermInfo) ProtoMessage() {}
+
+func (x *SolarTermInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolarTermInfo.ProtoReflect.Descriptor instead.
+func (*SolarTermInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SolarTermInfo) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SolarTermInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SolarTermInfo) GetLongitudeDeg() float64 {
+	if x != nil {
+		return x.LongitudeDeg
+	}
+	return 0
+}
+
+func (x *SolarTermInfo) GetIsJie() bool {
+	if x != nil {
+		return x.IsJie
+	}
+	return false
+}
+
+func (x *SolarTermInfo) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *SolarTermInfo) GetUnix() int64 {
+	if x != nil {
+		return x.Unix
+	}
+	return 0
+}
+
+type SolarTermsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code          int32            `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Year          int32            `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Terms         []*SolarTermInfo `protobuf:"bytes,4,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SolarTermsResponse) Reset() {
+	*x = SolarTermsResponse{}
+	mi := &file_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SolarTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolarTermsResponse) ProtoMessage() {}
+
+func (x *SolarTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolarTermsResponse.ProtoReflect.Descriptor instead.
+func (*SolarTermsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SolarTermsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SolarTermsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SolarTermsResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SolarTermsResponse) GetTerms() []*SolarTermInfo {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type GeoCacheListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional substring filter on the cache key ("country|province|city[|district]").
+	Keyword string `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// 1-based page number; page_size defaults to 50 (max 500).
+	Page          int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoCacheListRequest) Reset() {
+	*x = GeoCacheListRequest{}
+	mi := &file_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoCacheListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCacheListRequest) ProtoMessage() {}
+
+func (x *GeoCacheListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCacheListRequest.ProtoReflect.Descriptor instead.
+func (*GeoCacheListRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GeoCacheListRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *GeoCacheListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GeoCacheListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GeoCacheEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "country|province|city[|district]" after name normalization, e.g. "CN|浙江|杭州".
+	Key       string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Geocoder that produced the entry, e.g. "amap".
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Beijing time "YYYY-MM-DD HH:mm:ss".
+	CreatedAt string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt string `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// true if the entry is also held in the in-memory LRU.
+	InMemory      bool `protobuf:"varint,7,opt,name=in_memory,json=inMemory,proto3" json:"in_memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoCacheEntry) Reset() {
+	*x = GeoCacheEntry{}
+	mi := &file_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoCacheEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCacheEntry) ProtoMessage() {}
+
+func (x *GeoCacheEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCacheEntry.ProtoReflect.Descriptor instead.
+func (*GeoCacheEntry) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GeoCacheEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoCacheEntry) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GeoCacheEntry) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoCacheEntry) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *GeoCacheEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GeoCacheEntry) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *GeoCacheEntry) GetInMemory() bool {
+	if x != nil {
+		return x.InMemory
+	}
+	return false
+}
+
+type GeoCacheListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Total entries matching keyword (MySQL tier, or memory tier without MySQL).
+	Total   int32            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Entries []*GeoCacheEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	// In-memory LRU occupancy.
+	MemorySize     int32 `protobuf:"varint,5,opt,name=memory_size,json=memorySize,proto3" json:"memory_size,omitempty"`
+	MemoryCapacity int32 `protobuf:"varint,6,opt,name=memory_capacity,json=memoryCapacity,proto3" json:"memory_capacity,omitempty"`
+	// Cache TTL in seconds.
+	TtlSeconds    int64 `protobuf:"varint,7,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoCacheListResponse) Reset() {
+	*x = GeoCacheListResponse{}
+	mi := &file_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoCacheListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCacheListResponse) ProtoMessage() {}
+
+func (x *GeoCacheListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCacheListResponse.ProtoReflect.Descriptor instead.
+func (*GeoCacheListResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GeoCacheListResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GeoCacheListResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GeoCacheListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GeoCacheListResponse) GetEntries() []*GeoCacheEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GeoCacheListResponse) GetMemorySize() int32 {
+	if x != nil {
+		return x.MemorySize
+	}
+	return 0
+}
+
+func (x *GeoCacheListResponse) GetMemoryCapacity() int32 {
+	if x != nil {
+		return x.MemoryCapacity
+	}
+	return 0
+}
+
+func (x *GeoCacheListResponse) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type GeoCachePurgeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of key / expired_only / all must be set.
+	Key           string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ExpiredOnly   bool   `protobuf:"varint,2,opt,name=expired_only,json=expiredOnly,proto3" json:"expired_only,omitempty"`
+	All           bool   `protobuf:"varint,3,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoCachePurgeRequest) Reset() {
+	*x = GeoCachePurgeRequest{}
+	mi := &file_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoCachePurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCachePurgeRequest) ProtoMessage() {}
+
+func (x *GeoCachePurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCachePurgeRequest.ProtoReflect.Descriptor instead.
+func (*GeoCachePurgeRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GeoCachePurgeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GeoCachePurgeRequest) GetExpiredOnly() bool {
+	if x != nil {
+		return x.ExpiredOnly
+	}
+	return false
+}
+
+func (x *GeoCachePurgeRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+type GeoCachePurgeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// MySQL rows removed (in-memory entries removed when MySQL is not configured).
+	Purged        int64 `protobuf:"varint,3,opt,name=purged,proto3" json:"purged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeoCachePurgeResponse) Reset() {
+	*x = GeoCachePurgeResponse{}
+	mi := &file_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeoCachePurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoCachePurgeResponse) ProtoMessage() {}
+
+func (x *GeoCachePurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoCachePurgeResponse.ProtoReflect.Descriptor instead.
+func (*GeoCachePurgeResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GeoCachePurgeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GeoCachePurgeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GeoCachePurgeResponse) GetPurged() int64 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+type TimelineRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Birth inputs as for /admin/reasoning; gender is required (it sets the direction).
+	Birth *ReasoningRequest `protobuf:"bytes,1,opt,name=birth,proto3" json:"birth,omitempty"`
+	// 1-based page over luck pillars; page_size is decades per page, default 8 (max 12).
+	Page     int32 `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Optional 流年 span, Gregorian years inclusive: from the birth year on, at most 120
+	// years. Returned in TimelineResponse.annual regardless of the luck pillar page.
+	StartYear     int32 `protobuf:"varint,4,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	EndYear       int32 `protobuf:"varint,5,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineRequest) Reset() {
+	*x = TimelineRequest{}
+	mi := &file_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineRequest) ProtoMessage() {}
+
+func (x *TimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineRequest.ProtoReflect.Descriptor instead.
+func (*TimelineRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TimelineRequest) GetBirth() *ReasoningRequest {
+	if x != nil {
+		return x.Birth
+	}
+	return nil
+}
+
+func (x *TimelineRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TimelineRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TimelineRequest) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *TimelineRequest) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+type InteractionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "天干五合", "地支六冲", "地支三刑".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// e.g. "甲己合土", "子午冲".
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Participating positions: "year", "month", "day", "hour", "luck", "annual".
+	Positions []string `protobuf:"bytes,3,rep,name=positions,proto3" json:"positions,omitempty"`
+	// Element a combination transforms towards; empty for other kinds.
+	Element       string `protobuf:"bytes,4,opt,name=element,proto3" json:"element,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InteractionInfo) Reset() {
+	*x = InteractionInfo{}
+	mi := &file_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InteractionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InteractionInfo) ProtoMessage() {}
+
+func (x *InteractionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InteractionInfo.ProtoReflect.Descriptor instead.
+func (*InteractionInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InteractionInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InteractionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InteractionInfo) GetPositions() []string {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+func (x *InteractionInfo) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+type AnnualPillarInfo struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Year   int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Pillar string                 `protobuf:"bytes,2,opt,name=pillar,proto3" json:"pillar,omitempty"`
+	// Age in 虚岁: 1 in the year of the natal year pillar, which for a birth before
+	// 立春 is the Gregorian year before. Unset when the year pillar is undetermined.
+	Age int32 `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`
+	// Ten Gods of the stem and of the branch's main qi relative to the day master;
+	// empty when the day pillar is undetermined.
+	StemGod   string `protobuf:"bytes,4,opt,name=stem_god,json=stemGod,proto3" json:"stem_god,omitempty"`
+	BranchGod string `protobuf:"bytes,5,opt,name=branch_god,json=branchGod,proto3" json:"branch_god,omitempty"`
+	// Relations of this annual pillar with the natal pillars and its luck pillar.
+	Interactions []*InteractionInfo `protobuf:"bytes,6,rep,name=interactions,proto3" json:"interactions,omitempty"`
+	// The luck pillar governing the year; empty before the cycle starts (起运).
+	LuckPillar    string `protobuf:"bytes,7,opt,name=luck_pillar,json=luckPillar,proto3" json:"luck_pillar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnnualPillarInfo) Reset() {
+	*x = AnnualPillarInfo{}
+	mi := &file_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnnualPillarInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnnualPillarInfo) ProtoMessage() {}
+
+func (x *AnnualPillarInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnnualPillarInfo.ProtoReflect.Descriptor instead.
+func (*AnnualPillarInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AnnualPillarInfo) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *AnnualPillarInfo) GetPillar() string {
+	if x != nil {
+		return x.Pillar
+	}
+	return ""
+}
+
+func (x *AnnualPillarInfo) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *AnnualPillarInfo) GetStemGod() string {
+	if x != nil {
+		return x.StemGod
+	}
+	return ""
+}
+
+func (x *AnnualPillarInfo) GetBranchGod() string {
+	if x != nil {
+		return x.BranchGod
+	}
+	return ""
+}
+
+func (x *AnnualPillarInfo) GetInteractions() []*InteractionInfo {
+	if x != nil {
+		return x.Interactions
+	}
+	return nil
+}
+
+func (x *AnnualPillarInfo) GetLuckPillar() string {
+	if x != nil {
+		return x.LuckPillar
+	}
+	return ""
+}
+
+type LuckPillarInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0-based position in the cycle.
+	Index  int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Pillar string `protobuf:"bytes,2,opt,name=pillar,proto3" json:"pillar,omitempty"`
+	// Ten Gods as in AnnualPillarInfo.
+	StemGod   string `protobuf:"bytes,3,opt,name=stem_god,json=stemGod,proto3" json:"stem_god,omitempty"`
+	BranchGod string `protobuf:"bytes,4,opt,name=branch_god,json=branchGod,proto3" json:"branch_god,omitempty"`
+	// Completed years (周岁) when the pillar starts, and the start date "YYYY-MM-DD".
+	StartAge  int32  `protobuf:"varint,5,opt,name=start_age,json=startAge,proto3" json:"start_age,omitempty"`
+	StartDate string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Gregorian years governed by the pillar, with their annual pillars.
+	StartYear int32               `protobuf:"varint,7,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	EndYear   int32               `protobuf:"varint,8,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	Annual    []*AnnualPillarInfo `protobuf:"bytes,9,rep,name=annual,proto3" json:"annual,omitempty"`
+	// Relations of this luck pillar with the natal pillars.
+	Interactions  []*InteractionInfo `protobuf:"bytes,10,rep,name=interactions,proto3" json:"interactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LuckPillarInfo) Reset() {
+	*x = LuckPillarInfo{}
+	mi := &file_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LuckPillarInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LuckPillarInfo) ProtoMessage() {}
+
+func (x *LuckPillarInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LuckPillarInfo.ProtoReflect.Descriptor instead.
+func (*LuckPillarInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LuckPillarInfo) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LuckPillarInfo) GetPillar() string {
+	if x != nil {
+		return x.Pillar
+	}
+	return ""
+}
+
+func (x *LuckPillarInfo) GetStemGod() string {
+	if x != nil {
+		return x.StemGod
+	}
+	return ""
+}
+
+func (x *LuckPillarInfo) GetBranchGod() string {
+	if x != nil {
+		return x.BranchGod
+	}
+	return ""
+}
+
+func (x *LuckPillarInfo) GetStartAge() int32 {
+	if x != nil {
+		return x.StartAge
+	}
+	return 0
+}
+
+func (x *LuckPillarInfo) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *LuckPillarInfo) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *LuckPillarInfo) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+func (x *LuckPillarInfo) GetAnnual() []*AnnualPillarInfo {
+	if x != nil {
+		return x.Annual
+	}
+	return nil
+}
+
+func (x *LuckPillarInfo) GetInteractions() []*InteractionInfo {
+	if x != nil {
+		return x.Interactions
+	}
+	return nil
+}
+
+type TimelineResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// "年柱 月柱 日柱 时柱"; "？？" for pillars the uncertain birth time leaves open.
+	Bazi string `protobuf:"bytes,3,opt,name=bazi,proto3" json:"bazi,omitempty"`
+	// The luck cycle: unset, with luck_pillars and the paging fields, when the year
+	// or month pillar is undetermined, as direction and pillars follow from them.
+	// "forward" (顺排) or "backward" (逆排).
+	Direction string `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	// Age at which the first luck pillar starts (起运), e.g. 3 years 4 months 10 days.
+	StartYears  int32 `protobuf:"varint,5,opt,name=start_years,json=startYears,proto3" json:"start_years,omitempty"`
+	StartMonths int32 `protobuf:"varint,6,opt,name=start_months,json=startMonths,proto3" json:"start_months,omitempty"`
+	StartDays   int32 `protobuf:"varint,7,opt,name=start_days,json=startDays,proto3" json:"start_days,omitempty"`
+	// The 节 the start age was counted to, and its local time "YYYY-MM-DD HH:mm:ss".
+	JieName     string            `protobuf:"bytes,8,opt,name=jie_name,json=jieName,proto3" json:"jie_name,omitempty"`
+	JieTime     string            `protobuf:"bytes,9,opt,name=jie_time,json=jieTime,proto3" json:"jie_time,omitempty"`
+	Page        int32             `protobuf:"varint,10,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize    int32             `protobuf:"varint,11,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	TotalPages  int32             `protobuf:"varint,12,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	LuckPillars []*LuckPillarInfo `protobuf:"bytes,13,rep,name=luck_pillars,json=luckPillars,proto3" json:"luck_pillars,omitempty"`
+	// 子时 convention the chart was computed with: "zi_initial", "split_zi" or "midnight".
+	DayBoundary string `protobuf:"bytes,14,opt,name=day_boundary,json=dayBoundary,proto3" json:"day_boundary,omitempty"`
+	// true when the birth time is a range or unknown: the start age is taken at the
+	// middle of the range, and undetermined pillars take no part in the interactions.
+	Approximate bool `protobuf:"varint,15,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	// Annual pillars of the requested start_year..end_year span.
+	Annual        []*AnnualPillarInfo `protobuf:"bytes,16,rep,name=annual,proto3" json:"annual,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimelineResponse) Reset() {
+	*x = TimelineResponse{}
+	mi := &file_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineResponse) ProtoMessage() {}
+
+func (x *TimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineResponse.ProtoReflect.Descriptor instead.
+func (*TimelineResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TimelineResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetBazi() string {
+	if x != nil {
+		return x.Bazi
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetStartYears() int32 {
+	if x != nil {
+		return x.StartYears
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetStartMonths() int32 {
+	if x != nil {
+		return x.StartMonths
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetStartDays() int32 {
+	if x != nil {
+		return x.StartDays
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetJieName() string {
+	if x != nil {
+		return x.JieName
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetJieTime() string {
+	if x != nil {
+		return x.JieTime
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *TimelineResponse) GetLuckPillars() []*LuckPillarInfo {
+	if x != nil {
+		return x.LuckPillars
+	}
+	return nil
+}
+
+func (x *TimelineResponse) GetDayBoundary() string {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return ""
+}
+
+func (x *TimelineResponse) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+func (x *TimelineResponse) GetAnnual() []*AnnualPillarInfo {
+	if x != nil {
+		return x.Annual
+	}
+	return nil
+}
+
+type CompatibilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Birth inputs of the two persons, as for /admin/reasoning.
+	First         *ReasoningRequest `protobuf:"bytes,1,opt,name=first,proto3" json:"first,omitempty"`
+	Second        *ReasoningRequest `protobuf:"bytes,2,opt,name=second,proto3" json:"second,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityRequest) Reset() {
+	*x = CompatibilityRequest{}
+	mi := &file_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityRequest) ProtoMessage() {}
+
+func (x *CompatibilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityRequest.ProtoReflect.Descriptor instead.
+func (*CompatibilityRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CompatibilityRequest) GetFirst() *ReasoningRequest {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *CompatibilityRequest) GetSecond() *ReasoningRequest {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+type ChartSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Bazi  string                 `protobuf:"bytes,1,opt,name=bazi,proto3" json:"bazi,omitempty"`
+	// Day master stem and its element, e.g. "丙", "火"; empty when the day pillar is
+	// undetermined.
+	DayMaster        string `protobuf:"bytes,2,opt,name=day_master,json=dayMaster,proto3" json:"day_master,omitempty"`
+	DayMasterElement string `protobuf:"bytes,3,opt,name=day_master_element,json=dayMasterElement,proto3" json:"day_master_element,omitempty"`
+	// 极弱 | 偏弱 | 中和 | 偏强 | 极强; empty when approximate (it needs all four pillars).
+	Strength string `protobuf:"bytes,4,opt,name=strength,proto3" json:"strength,omitempty"`
+	// Favourable elements (喜用), e.g. ["水", "木"]; empty when approximate.
+	Favourable []string `protobuf:"bytes,5,rep,name=favourable,proto3" json:"favourable,omitempty"`
+	// Zodiac animal of the year branch, e.g. "马"; empty when the year is undetermined.
+	Zodiac      string `protobuf:"bytes,6,opt,name=zodiac,proto3" json:"zodiac,omitempty"`
+	DayBoundary string `protobuf:"bytes,7,opt,name=day_boundary,json=dayBoundary,proto3" json:"day_boundary,omitempty"`
+	// true when the birth time is uncertain and leaves some pillars undetermined: they
+	// show as "？？" in bazi and are left out of the relations and the scores needing them.
+	Approximate   bool `protobuf:"varint,8,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChartSummary) Reset() {
+	*x = ChartSummary{}
+	mi := &file_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChartSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartSummary) ProtoMessage() {}
+
+func (x *ChartSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartSummary.ProtoReflect.Descriptor instead.
+func (*ChartSummary) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ChartSummary) GetBazi() string {
+	if x != nil {
+		return x.Bazi
+	}
+	return ""
+}
+
+func (x *ChartSummary) GetDayMaster() string {
+	if x != nil {
+		return x.DayMaster
+	}
+	return ""
+}
+
+func (x *ChartSummary) GetDayMasterElement() string {
+	if x != nil {
+		return x.DayMasterElement
+	}
+	return ""
+}
+
+func (x *ChartSummary) GetStrength() string {
+	if x != nil {
+		return x.Strength
+	}
+	return ""
+}
+
+func (x *ChartSummary) GetFavourable() []string {
+	if x != nil {
+		return x.Favourable
+	}
+	return nil
+}
+
+func (x *ChartSummary) GetZodiac() string {
+	if x != nil {
+		return x.Zodiac
+	}
+	return ""
+}
+
+func (x *ChartSummary) GetDayBoundary() string {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return ""
+}
+
+func (x *ChartSummary) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+type CompatibilityAspect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "day_master", "spouse_palace", "zodiac" or "elements".
+	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// e.g. "日干甲己相合".
+	Title  string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// Contribution to the total score; negative for unfavourable findings.
+	Score         int32 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityAspect) Reset() {
+	*x = CompatibilityAspect{}
+	mi := &file_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityAspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityAspect) ProtoMessage() {}
+
+func (x *CompatibilityAspect) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityAspect.ProtoReflect.Descriptor instead.
+func (*CompatibilityAspect) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CompatibilityAspect) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CompatibilityAspect) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CompatibilityAspect) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *CompatibilityAspect) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CrossInteractionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// As in InteractionInfo.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Position of the pillar in the first and in the second chart: "year", "month", "day", "hour".
+	FirstPosition  string `protobuf:"bytes,3,opt,name=first_position,json=firstPosition,proto3" json:"first_position,omitempty"`
+	SecondPosition string `protobuf:"bytes,4,opt,name=second_position,json=secondPosition,proto3" json:"second_position,omitempty"`
+	Element        string `protobuf:"bytes,5,opt,name=element,proto3" json:"element,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CrossInteractionInfo) Reset() {
+	*x = CrossInteractionInfo{}
+	mi := &file_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CrossInteractionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrossInteractionInfo) ProtoMessage() {}
+
+func (x *CrossInteractionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrossInteractionInfo.ProtoReflect.Descriptor instead.
+func (*CrossInteractionInfo) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CrossInteractionInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CrossInteractionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CrossInteractionInfo) GetFirstPosition() string {
+	if x != nil {
+		return x.FirstPosition
+	}
+	return ""
+}
+
+func (x *CrossInteractionInfo) GetSecondPosition() string {
+	if x != nil {
+		return x.SecondPosition
+	}
+	return ""
+}
+
+func (x *CrossInteractionInfo) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+type CompatibilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string        `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	First   *ChartSummary `protobuf:"bytes,3,opt,name=first,proto3" json:"first,omitempty"`
+	Second  *ChartSummary `protobuf:"bytes,4,opt,name=second,proto3" json:"second,omitempty"`
+	// 0..100; level labels it, e.g. "上等婚配".
+	Score   int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	Level   string                 `protobuf:"bytes,6,opt,name=level,proto3" json:"level,omitempty"`
+	Aspects []*CompatibilityAspect `protobuf:"bytes,7,rep,name=aspects,proto3" json:"aspects,omitempty"`
+	// Relations between every pair of pillars across the two charts.
+	Interactions  []*CrossInteractionInfo `protobuf:"bytes,8,rep,name=interactions,proto3" json:"interactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityResponse) Reset() {
+	*x = CompatibilityResponse{}
+	mi := &file_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityResponse) ProtoMessage() {}
+
+func (x *CompatibilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityResponse.ProtoReflect.Descriptor instead.
+func (*CompatibilityResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CompatibilityResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompatibilityResponse) GetFirst() *ChartSummary {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *CompatibilityResponse) GetSecond() *ChartSummary {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *CompatibilityResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *CompatibilityResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *CompatibilityResponse) GetAspects() []*CompatibilityAspect {
+	if x != nil {
+		return x.Aspects
+	}
+	return nil
+}
+
+func (x *CompatibilityResponse) GetInteractions() []*CrossInteractionInfo {
+	if x != nil {
+		return x.Interactions
+	}
+	return nil
+}
+
+type ReverseLookupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Any of the four pillars, e.g. "庚午"; empty matches anything. At least one is required.
+	YearPillar  string `protobuf:"bytes,1,opt,name=year_pillar,json=yearPillar,proto3" json:"year_pillar,omitempty"`
+	MonthPillar string `protobuf:"bytes,2,opt,name=month_pillar,json=monthPillar,proto3" json:"month_pillar,omitempty"`
+	DayPillar   string `protobuf:"bytes,3,opt,name=day_pillar,json=dayPillar,proto3" json:"day_pillar,omitempty"`
+	HourPillar  string `protobuf:"bytes,4,opt,name=hour_pillar,json=hourPillar,proto3" json:"hour_pillar,omitempty"`
+	// Gregorian years to search, inclusive (Beijing time), within 1000..3000 and
+	// spanning at most 300 years.
+	StartYear int32 `protobuf:"varint,5,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	EndYear   int32 `protobuf:"varint,6,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	// 子时 convention; unspecified uses the server default.
+	DayBoundary DayBoundary `protobuf:"varint,7,opt,name=day_boundary,json=dayBoundary,proto3,enum=trpc.llyb.backend.admin.DayBoundary" json:"day_boundary,omitempty"`
+	// Birth place longitude in degrees East. When set, the day and hour pillars are read
+	// from true solar time there; otherwise from Beijing time.
+	Longitude *float64 `protobuf:"fixed64,8,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	// Maximum number of windows, default 100 (max 500).
+	Limit         int32 `protobuf:"varint,9,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseLookupRequest) Reset() {
+	*x = ReverseLookupRequest{}
+	mi := &file_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseLookupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseLookupRequest) ProtoMessage() {}
+
+func (x *ReverseLookupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseLookupRequest.ProtoReflect.Descriptor instead.
+func (*ReverseLookupRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReverseLookupRequest) GetYearPillar() string {
+	if x != nil {
+		return x.YearPillar
+	}
+	return ""
+}
+
+func (x *ReverseLookupRequest) GetMonthPillar() string {
+	if x != nil {
+		return x.MonthPillar
+	}
+	return ""
+}
+
+func (x *ReverseLookupRequest) GetDayPillar() string {
+	if x != nil {
+		return x.DayPillar
+	}
+	return ""
+}
+
+func (x *ReverseLookupRequest) GetHourPillar() string {
+	if x != nil {
+		return x.HourPillar
+	}
+	return ""
+}
+
+func (x *ReverseLookupRequest) GetStartYear() int32 {
+	if x != nil {
+		return x.StartYear
+	}
+	return 0
+}
+
+func (x *ReverseLookupRequest) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+func (x *ReverseLookupRequest) GetDayBoundary() DayBoundary {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return DayBoundary_DAY_BOUNDARY_UNSPECIFIED
+}
+
+func (x *ReverseLookupRequest) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *ReverseLookupRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DatetimeWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Bazi  string                 `protobuf:"bytes,1,opt,name=bazi,proto3" json:"bazi,omitempty"`
+	// Birth instants [start, end) as clock readings "YYYY-MM-DD HH:mm:ss" under China's
+	// civil time, as /admin/reasoning reads them: Beijing time, summer time in
+	// 1986-1991, the 中原 zone (UTC+8) from 1912 to 1949 and local mean time at the
+	// longitude (120°E without one) before 1912. Births of 1912-1949 in other zones
+	// are read in 中原 time.
+	Start     string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End       string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	StartUnix int64  `protobuf:"varint,4,opt,name=start_unix,json=startUnix,proto3" json:"start_unix,omitempty"`
+	EndUnix   int64  `protobuf:"varint,5,opt,name=end_unix,json=endUnix,proto3" json:"end_unix,omitempty"`
+	// The same window on the clock the day and hour pillars are read from (true solar
+	// time at the longitude, or Beijing time).
+	TrueSolarStart string `protobuf:"bytes,6,opt,name=true_solar_start,json=trueSolarStart,proto3" json:"true_solar_start,omitempty"`
+	TrueSolarEnd   string `protobuf:"bytes,7,opt,name=true_solar_end,json=trueSolarEnd,proto3" json:"true_solar_end,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DatetimeWindow) Reset() {
+	*x = DatetimeWindow{}
+	mi := &file_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatetimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatetimeWindow) ProtoMessage() {}
+
+func (x *DatetimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatetimeWindow.ProtoReflect.Descriptor instead.
+func (*DatetimeWindow) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DatetimeWindow) GetBazi() string {
+	if x != nil {
+		return x.Bazi
+	}
+	return ""
+}
+
+func (x *DatetimeWindow) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DatetimeWindow) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *DatetimeWindow) GetStartUnix() int64 {
+	if x != nil {
+		return x.StartUnix
+	}
+	return 0
+}
+
+func (x *DatetimeWindow) GetEndUnix() int64 {
+	if x != nil {
+		return x.EndUnix
+	}
+	return 0
+}
+
+func (x *DatetimeWindow) GetTrueSolarStart() string {
+	if x != nil {
+		return x.TrueSolarStart
+	}
+	return ""
+}
+
+func (x *DatetimeWindow) GetTrueSolarEnd() string {
+	if x != nil {
+		return x.TrueSolarEnd
+	}
+	return ""
+}
+
+type ReverseLookupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Windows in chronological order.
+	Windows []*DatetimeWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	// true if more windows match than the limit allowed.
+	Truncated     bool   `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	DayBoundary   string `protobuf:"bytes,5,opt,name=day_boundary,json=dayBoundary,proto3" json:"day_boundary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReverseLookupResponse) Reset() {
+	*x = ReverseLookupResponse{}
+	mi := &file_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseLookupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseLookupResponse) ProtoMessage() {}
+
+func (x *ReverseLookupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseLookupResponse.ProtoReflect.Descriptor instead.
+func (*ReverseLookupResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReverseLookupResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReverseLookupResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReverseLookupResponse) GetWindows() []*DatetimeWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *ReverseLookupResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ReverseLookupResponse) GetDayBoundary() string {
+	if x != nil {
+		return x.DayBoundary
+	}
+	return ""
+}
+
+type CalendarRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Gregorian year and month (1..12). Supported years: 1901..2099.
+	Year          int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month         int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarRequest) Reset() {
+	*x = CalendarRequest{}
+	mi := &file_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarRequest) ProtoMessage() {}
+
+func (x *CalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarRequest.ProtoReflect.Descriptor instead.
+func (*CalendarRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CalendarRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CalendarRequest) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+type CalendarDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Beijing date "YYYY-MM-DD"; weekday 0 = Sunday ... 6 = Saturday.
+	Date    string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Weekday int32  `protobuf:"varint,2,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// false for days of the neighbouring months that pad the grid.
+	InMonth     bool  `protobuf:"varint,3,opt,name=in_month,json=inMonth,proto3" json:"in_month,omitempty"`
+	LunarYear   int32 `protobuf:"varint,4,opt,name=lunar_year,json=lunarYear,proto3" json:"lunar_year,omitempty"`
+	LunarMonth  int32 `protobuf:"varint,5,opt,name=lunar_month,json=lunarMonth,proto3" json:"lunar_month,omitempty"`
+	LunarDay    int32 `protobuf:"varint,6,opt,name=lunar_day,json=lunarDay,proto3" json:"lunar_day,omitempty"`
+	IsLeapMonth bool  `protobuf:"varint,7,opt,name=is_leap_month,json=isLeapMonth,proto3" json:"is_leap_month,omitempty"`
+	// e.g. "闰四月", "初七".
+	LunarMonthName string `protobuf:"bytes,8,opt,name=lunar_month_name,json=lunarMonthName,proto3" json:"lunar_month_name,omitempty"`
+	LunarDayName   string `protobuf:"bytes,9,opt,name=lunar_day_name,json=lunarDayName,proto3" json:"lunar_day_name,omitempty"`
+	// Pillars of the day; the day of a 节 already counts in the new month.
+	YearPillar  string `protobuf:"bytes,10,opt,name=year_pillar,json=yearPillar,proto3" json:"year_pillar,omitempty"`
+	MonthPillar string `protobuf:"bytes,11,opt,name=month_pillar,json=monthPillar,proto3" json:"month_pillar,omitempty"`
+	DayPillar   string `protobuf:"bytes,12,opt,name=day_pillar,json=dayPillar,proto3" json:"day_pillar,omitempty"`
+	// Solar term falling on this day, if any.
+	SolarTerm     *SolarTermInfo `protobuf:"bytes,13,opt,name=solar_term,json=solarTerm,proto3" json:"solar_term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	mi := &file_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetInMonth() bool {
+	if x != nil {
+		return x.InMonth
+	}
+	return false
+}
+
+func (x *CalendarDay) GetLunarYear() int32 {
+	if x != nil {
+		return x.LunarYear
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetLunarMonth() int32 {
+	if x != nil {
+		return x.LunarMonth
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetLunarDay() int32 {
+	if x != nil {
+		return x.LunarDay
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetIsLeapMonth() bool {
+	if x != nil {
+		return x.IsLeapMonth
+	}
+	return false
+}
+
+func (x *CalendarDay) GetLunarMonthName() string {
+	if x != nil {
+		return x.LunarMonthName
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetLunarDayName() string {
+	if x != nil {
+		return x.LunarDayName
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetYearPillar() string {
+	if x != nil {
+		return x.YearPillar
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetMonthPillar() string {
+	if x != nil {
+		return x.MonthPillar
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetDayPillar() string {
+	if x != nil {
+		return x.DayPillar
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetSolarTerm() *SolarTermInfo {
+	if x != nil {
+		return x.SolarTerm
+	}
+	return nil
+}
+
+type CalendarResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Year    int32  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Month   int32  `protobuf:"varint,4,opt,name=month,proto3" json:"month,omitempty"`
+	// Whole weeks from Monday to Sunday covering the month.
+	Days []*CalendarDay `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	// Also sent as the ETag header; the content for a month never changes.
+	Etag          string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalendarResponse) Reset() {
+	*x = CalendarResponse{}
+	mi := &file_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarResponse) ProtoMessage() {}
+
+func (x *CalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarResponse.ProtoReflect.Descriptor instead.
+func (*CalendarResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CalendarResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CalendarResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CalendarResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CalendarResponse) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *CalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CalendarResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type AlmanacDayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Beijing date "YYYY-MM-DD" within 1901..2099; empty means today.
+	Date          string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlmanacDayRequest) Reset() {
+	*x = AlmanacDayRequest{}
+	mi := &file_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlmanacDayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlmanacDayRequest) ProtoMessage() {}
+
+func (x *AlmanacDayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlmanacDayRequest.ProtoReflect.Descriptor instead.
+func (*AlmanacDayRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AlmanacDayRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type AlmanacDayResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Calendar data of the day, as in the month grid.
+	Day *CalendarDay `protobuf:"bytes,3,opt,name=day,proto3" json:"day,omitempty"`
+	// 建除十二神, e.g. "建".
+	Officer string `protobuf:"bytes,4,opt,name=officer,proto3" json:"officer,omitempty"`
+	// 值日星宿, e.g. "角木蛟", and whether it is auspicious (吉).
+	Mansion           string `protobuf:"bytes,5,opt,name=mansion,proto3" json:"mansion,omitempty"`
+	MansionAuspicious bool   `protobuf:"varint,6,opt,name=mansion_auspicious,json=mansionAuspicious,proto3" json:"mansion_auspicious,omitempty"`
+	// 彭祖百忌 of the day stem and of the day branch.
+	PengZu []string `protobuf:"bytes,7,rep,name=peng_zu,json=pengZu,proto3" json:"peng_zu,omitempty"`
+	// e.g. "冲马（戊午）", "煞南".
+	Clash string `protobuf:"bytes,8,opt,name=clash,proto3" json:"clash,omitempty"`
+	Sha   string `protobuf:"bytes,9,opt,name=sha,proto3" json:"sha,omitempty"`
+	// true if the day clashes the year (岁破).
+	YearClash bool `protobuf:"varint,10,opt,name=year_clash,json=yearClash,proto3" json:"year_clash,omitempty"`
+	// Suitable (宜) and unsuitable (忌) activities.
+	Yi []string `protobuf:"bytes,11,rep,name=yi,proto3" json:"yi,omitempty"`
+	Ji []string `protobuf:"bytes,12,rep,name=ji,proto3" json:"ji,omitempty"`
+	// Also sent as the ETag header when a date is given.
+	Etag          string `protobuf:"bytes,13,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AlmanacDayResponse) Reset() {
+	*x = AlmanacDayResponse{}
+	mi := &file_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlmanacDayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlmanacDayResponse) ProtoMessage() {}
+
+func (x *AlmanacDayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlmanacDayResponse.ProtoReflect.Descriptor instead.
+func (*AlmanacDayResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AlmanacDayResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AlmanacDayResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AlmanacDayResponse) GetDay() *CalendarDay {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *AlmanacDayResponse) GetOfficer() string {
+	if x != nil {
+		return x.Officer
+	}
+	return ""
+}
+
+func (x *AlmanacDayResponse) GetMansion() string {
+	if x != nil {
+		return x.Mansion
+	}
+	return ""
+}
+
+func (x *AlmanacDayResponse) GetMansionAuspicious() bool {
+	if x != nil {
+		return x.MansionAuspicious
+	}
+	return false
+}
+
+func (x *AlmanacDayResponse) GetPengZu() []string {
+	if x != nil {
+		return x.PengZu
+	}
+	return nil
+}
+
+func (x *AlmanacDayResponse) GetClash() string {
+	if x != nil {
+		return x.Clash
+	}
+	return ""
+}
+
+func (x *AlmanacDayResponse) GetSha() string {
+	if x != nil {
+		return x.Sha
+	}
+	return ""
+}
+
+func (x *AlmanacDayResponse) GetYearClash() bool {
+	if x != nil {
+		return x.YearClash
+	}
+	return false
+}
+
+func (x *AlmanacDayResponse) GetYi() []string {
+	if x != nil {
+		return x.Yi
+	}
+	return nil
+}
+
+func (x *AlmanacDayResponse) GetJi() []string {
+	if x != nil {
+		return x.Ji
+	}
+	return nil
+}
+
+func (x *AlmanacDayResponse) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DateSelectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "wedding", "moving", "opening", or an activity of the almanac, e.g. "嫁娶".
+	Activity string `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity,omitempty"`
+	// Birth inputs of 1..6 participants, as for /admin/reasoning.
+	Participants []*ReasoningRequest `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	// Beijing dates "YYYY-MM-DD", inclusive, at most 366 days apart.
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Number of days returned, default 10 (max 60).
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateSelectionRequest) Reset() {
+	*x = DateSelectionRequest{}
+	mi := &file_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateSelectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateSelectionRequest) ProtoMessage() {}
+
+func (x *DateSelectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateSelectionRequest.ProtoReflect.Descriptor instead.
+func (*DateSelectionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DateSelectionRequest) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *DateSelectionRequest) GetParticipants() []*ReasoningRequest {
+	if x != nil {
+		return x.Participants
+	}
+	return nil
+}
+
+func (x *DateSelectionRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DateSelectionRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DateSelectionRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DateReason struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "成日宜嫁娶", "第1人生肖马：子午冲".
+	Text          string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Score         int32  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateReason) Reset() {
+	*x = DateReason{}
+	mi := &file_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateReason) ProtoMessage() {}
+
+func (x *DateReason) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateReason.ProtoReflect.Descriptor instead.
+func (*DateReason) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DateReason) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *DateReason) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type DateCandidate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Date      string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	DayPillar string                 `protobuf:"bytes,2,opt,name=day_pillar,json=dayPillar,proto3" json:"day_pillar,omitempty"`
+	Score     int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// true if the activity is 宜 and the day clashes no participant's year or day branch.
+	Suitable bool `protobuf:"varint,4,opt,name=suitable,proto3" json:"suitable,omitempty"`
+	// 建除十二神 and 值日星宿 of the day.
+	Officer       string        `protobuf:"bytes,5,opt,name=officer,proto3" json:"officer,omitempty"`
+	Mansion       string        `protobuf:"bytes,6,opt,name=mansion,proto3" json:"mansion,omitempty"`
+	Reasons       []*DateReason `protobuf:"bytes,7,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateCandidate) Reset() {
+	*x = DateCandidate{}
+	mi := &file_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateCandidate) ProtoMessage() {}
+
+func (x *DateCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateCandidate.ProtoReflect.Descriptor instead.
+func (*DateCandidate) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DateCandidate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DateCandidate) GetDayPillar() string {
+	if x != nil {
+		return x.DayPillar
+	}
+	return ""
+}
+
+func (x *DateCandidate) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *DateCandidate) GetSuitable() bool {
+	if x != nil {
+		return x.Suitable
+	}
+	return false
+}
+
+func (x *DateCandidate) GetOfficer() string {
+	if x != nil {
+		return x.Officer
+	}
+	return ""
+}
+
+func (x *DateCandidate) GetMansion() string {
+	if x != nil {
+		return x.Mansion
+	}
+	return ""
+}
+
+func (x *DateCandidate) GetReasons() []*DateReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+type DateSelectionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error. 1004 means the request
+	// was cancelled or timed out before the search finished.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Almanac activities the request was matched against, e.g. ["移徙", "入宅"].
+	Activities []string `protobuf:"bytes,3,rep,name=activities,proto3" json:"activities,omitempty"`
+	// Suitable days first, then by score.
+	Days []*DateCandidate `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	// Number of days evaluated.
+	Evaluated     int32 `protobuf:"varint,5,opt,name=evaluated,proto3" json:"evaluated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateSelectionResponse) Reset() {
+	*x = DateSelectionResponse{}
+	mi := &file_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateSelectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateSelectionResponse) ProtoMessage() {}
+
+func (x *DateSelectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateSelectionResponse.ProtoReflect.Descriptor instead.
+func (*DateSelectionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DateSelectionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DateSelectionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DateSelectionResponse) GetActivities() []string {
+	if x != nil {
+		return x.Activities
+	}
+	return nil
+}
+
+func (x *DateSelectionResponse) GetDays() []*DateCandidate {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *DateSelectionResponse) GetEvaluated() int32 {
+	if x != nil {
+		return x.Evaluated
+	}
+	return 0
+}
+
+type ZiweiChartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Birth inputs as for /admin/reasoning; gender is required (it sets the direction of 大限).
+	Birth         *ReasoningRequest `protobuf:"bytes,1,opt,name=birth,proto3" json:"birth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZiweiChartRequest) Reset() {
+	*x = ZiweiChartRequest{}
+	mi := &file_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZiweiChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZiweiChartRequest) ProtoMessage() {}
+
+func (x *ZiweiChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZiweiChartRequest.ProtoReflect.Descriptor instead.
+func (*ZiweiChartRequest) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ZiweiChartRequest) GetBirth() *ReasoningRequest {
+	if x != nil {
+		return x.Birth
+	}
+	return nil
+}
+
+type ZiweiStar struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// true for the fourteen main stars (十四主星).
+	Major bool `protobuf:"varint,2,opt,name=major,proto3" json:"major,omitempty"`
+	// 庙 旺 得 利 平 不 陷; empty for stars without a brightness table.
+	Brightness string `protobuf:"bytes,3,opt,name=brightness,proto3" json:"brightness,omitempty"`
+	// 化禄 化权 化科 化忌 by the year stem; empty if none.
+	Transform     string `protobuf:"bytes,4,opt,name=transform,proto3" json:"transform,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZiweiStar) Reset() {
+	*x = ZiweiStar{}
+	mi := &file_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZiweiStar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZiweiStar) ProtoMessage() {}
+
+func (x *ZiweiStar) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZiweiStar.ProtoReflect.Descriptor instead.
+func (*ZiweiStar) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ZiweiStar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ZiweiStar) GetMajor() bool {
+	if x != nil {
+		return x.Major
+	}
+	return false
+}
+
+func (x *ZiweiStar) GetBrightness() string {
+	if x != nil {
+		return x.Brightness
+	}
+	return ""
+}
+
+func (x *ZiweiStar) GetTransform() string {
+	if x != nil {
+		return x.Transform
+	}
+	return ""
+}
+
+type ZiweiPalace struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 命宫, 兄弟, 夫妻, 子女, 财帛, 疾厄, 迁移, 交友, 官禄, 田宅, 福德, 父母.
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Branch string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`
+	Stem   string `protobuf:"bytes,3,opt,name=stem,proto3" json:"stem,omitempty"`
+	// true for the palace 身宫 falls in.
+	Body  bool         `protobuf:"varint,4,opt,name=body,proto3" json:"body,omitempty"`
+	Stars []*ZiweiStar `protobuf:"bytes,5,rep,name=stars,proto3" json:"stars,omitempty"`
+	// 大限 of this palace, 虚岁 inclusive, e.g. 2..11 for 水二局's 命宫.
+	DecadeFrom    int32 `protobuf:"varint,6,opt,name=decade_from,json=decadeFrom,proto3" json:"decade_from,omitempty"`
+	DecadeTo      int32 `protobuf:"varint,7,opt,name=decade_to,json=decadeTo,proto3" json:"decade_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZiweiPalace) Reset() {
+	*x = ZiweiPalace{}
+	mi := &file_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZiweiPalace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZiweiPalace) ProtoMessage() {}
+
+func (x *ZiweiPalace) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZiweiPalace.ProtoReflect.Descriptor instead.
+func (*ZiweiPalace) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ZiweiPalace) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ZiweiPalace) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ZiweiPalace) GetStem() string {
+	if x != nil {
+		return x.Stem
+	}
+	return ""
+}
+
+func (x *ZiweiPalace) GetBody() bool {
+	if x != nil {
+		return x.Body
+	}
+	return false
+}
+
+func (x *ZiweiPalace) GetStars() []*ZiweiStar {
+	if x != nil {
+		return x.Stars
+	}
+	return nil
+}
+
+func (x *ZiweiPalace) GetDecadeFrom() int32 {
+	if x != nil {
+		return x.DecadeFrom
+	}
+	return 0
+}
+
+func (x *ZiweiPalace) GetDecadeTo() int32 {
+	if x != nil {
+		return x.DecadeTo
+	}
+	return 0
+}
+
+type ZiweiPlate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lunar birth date by true solar time.
+	LunarDate *LunarDateInfo `protobuf:"bytes,1,opt,name=lunar_date,json=lunarDate,proto3" json:"lunar_date,omitempty"`
+	// 干支 of the lunar year, e.g. "庚午", and the 时辰 branch, e.g. "辰".
+	YearPillar string `protobuf:"bytes,2,opt,name=year_pillar,json=yearPillar,proto3" json:"year_pillar,omitempty"`
+	Hour       string `protobuf:"bytes,3,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Branches of 命宫 and 身宫; body_palace is the palace 身宫 falls in, e.g. "官禄".
+	LifeBranch string `protobuf:"bytes,4,opt,name=life_branch,json=lifeBranch,proto3" json:"life_branch,omitempty"`
+	BodyBranch string `protobuf:"bytes,5,opt,name=body_branch,json=bodyBranch,proto3" json:"body_branch,omitempty"`
+	BodyPalace string `protobuf:"bytes,6,opt,name=body_palace,json=bodyPalace,proto3" json:"body_palace,omitempty"`
+	// 五行局, e.g. "水二局".
+	Bureau     string `protobuf:"bytes,7,opt,name=bureau,proto3" json:"bureau,omitempty"`
+	LifeMaster string `protobuf:"bytes,8,opt,name=life_master,json=lifeMaster,proto3" json:"life_master,omitempty"`
+	BodyMaster string `protobuf:"bytes,9,opt,name=body_master,json=bodyMaster,proto3" json:"body_master,omitempty"`
+	// "forward" (大限 clockwise, 阳男阴女) or "backward".
+	Direction string `protobuf:"bytes,10,opt,name=direction,proto3" json:"direction,omitempty"`
+	// The twelve palaces from 命宫.
+	Palaces       []*ZiweiPalace `protobuf:"bytes,11,rep,name=palaces,proto3" json:"palaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZiweiPlate) Reset() {
+	*x = ZiweiPlate{}
+	mi := &file_admin_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZiweiPlate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZiweiPlate) ProtoMessage() {}
+
+func (x *ZiweiPlate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZiweiPlate.ProtoReflect.Descriptor instead.
+func (*ZiweiPlate) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ZiweiPlate) GetLunarDate() *LunarDateInfo {
+	if x != nil {
+		return x.LunarDate
+	}
+	return nil
+}
+
+func (x *ZiweiPlate) GetYearPillar() string {
+	if x != nil {
+		return x.YearPillar
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetHour() string {
+	if x != nil {
+		return x.Hour
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetLifeBranch() string {
+	if x != nil {
+		return x.LifeBranch
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetBodyBranch() string {
+	if x != nil {
+		return x.BodyBranch
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetBodyPalace() string {
+	if x != nil {
+		return x.BodyPalace
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetBureau() string {
+	if x != nil {
+		return x.Bureau
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetLifeMaster() string {
+	if x != nil {
+		return x.LifeMaster
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetBodyMaster() string {
+	if x != nil {
+		return x.BodyMaster
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ZiweiPlate) GetPalaces() []*ZiweiPalace {
+	if x != nil {
+		return x.Palaces
+	}
+	return nil
+}
+
+type ZiweiCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Input clock times "HH:mm" (inclusive) that give this chart.
+	TimeFrom      string      `protobuf:"bytes,1,opt,name=time_from,json=timeFrom,proto3" json:"time_from,omitempty"`
+	TimeTo        string      `protobuf:"bytes,2,opt,name=time_to,json=timeTo,proto3" json:"time_to,omitempty"`
+	Chart         *ZiweiPlate `protobuf:"bytes,3,opt,name=chart,proto3" json:"chart,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZiweiCandidate) Reset() {
+	*x = ZiweiCandidate{}
+	mi := &file_admin_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZiweiCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZiweiCandidate) ProtoMessage() {}
+
+func (x *ZiweiCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZiweiCandidate.ProtoReflect.Descriptor instead.
+func (*ZiweiCandidate) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ZiweiCandidate) GetTimeFrom() string {
+	if x != nil {
+		return x.TimeFrom
+	}
+	return ""
+}
+
+func (x *ZiweiCandidate) GetTimeTo() string {
+	if x != nil {
+		return x.TimeTo
+	}
+	return ""
+}
+
+func (x *ZiweiCandidate) GetChart() *ZiweiPlate {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+type ZiweiChartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 means success; non-zero indicates an error.
+	Code    int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// How the birth time was corrected to true solar time.
+	SolarTime *SolarTimeInfo `protobuf:"bytes,3,opt,name=solar_time,json=solarTime,proto3" json:"solar_time,omitempty"`
+	// The chart, when the birth time determines it: an exact time, or a range that
+	// stays within one 时辰 and lunar date. Otherwise unset, see candidates.
+	Chart *ZiweiPlate `protobuf:"bytes,4,opt,name=chart,proto3" json:"chart,omitempty"`
+	// For an uncertain birth time spanning several 时辰 (or lunar dates): one chart per
+	// 时辰, in time order.
+	Candidates []*ZiweiCandidate `protobuf:"bytes,5,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// true if the birth time is a range or unknown.
+	Approximate   bool `protobuf:"varint,6,opt,name=approximate,proto3" json:"approximate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZiweiChartResponse) Reset() {
+	*x = ZiweiChartResponse{}
+	mi := &file_admin_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZiweiChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZiweiChartResponse) ProtoMessage() {}
+
+func (x *ZiweiChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZiweiChartResponse.ProtoReflect.Descriptor instead.
+func (*ZiweiChartResponse) Descriptor() ([]byte, []int) {
+	return file_admin_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ZiweiChartResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ZiweiChartResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ZiweiChartResponse) GetSolarTime() *SolarTimeInfo {
+	if x != nil {
+		return x.SolarTime
+	}
+	return nil
+}
+
+func (x *ZiweiChartResponse) GetChart() *ZiweiPlate {
+	if x != nil {
+		return x.Chart
+	}
+	return nil
+}
+
+func (x *ZiweiChartResponse) GetCandidates() []*ZiweiCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ZiweiChartResponse) GetApproximate() bool {
+	if x != nil {
+		return x.Approximate
+	}
+	return false
+}
+
+var File_admin_proto protoreflect.FileDescriptor
+
+const file_admin_proto_rawDesc = "" +
+	"\n" +
+	"\vadmin.proto\x12\x17trpc.llyb.backend.admin\x1a\x1dtrpc/proto/trpc_options.proto\"F\n" +
+	"\fLoginRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"9\n" +
+	"\rLoginResponse\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"I\n" +
+	"\x0fRegisterRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"_\n" +
+	"\x10RegisterResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x87\x05\n" +
+	"\x10ReasoningRequest\x127\n" +
+	"\x06gender\x18\x01 \x01(\x0e2\x1f.trpc.llyb.backend.admin.GenderR\x06gender\x12\x1d\n" +
+	"\n" +
+	"solar_date\x18\x02 \x01(\tR\tsolarDate\x12\x1d\n" +
+	"\n" +
+	"birth_time\x18\x03 \x01(\tR\tbirthTime\x12\x1a\n" +
+	"\bprovince\x18\x04 \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x1d\n" +
+	"\n" +
+	"lunar_date\x18\x06 \x01(\tR\tlunarDate\x12\"\n" +
+	"\ris_leap_month\x18\a \x01(\bR\visLeapMonth\x12\x18\n" +
+	"\acountry\x18\b \x01(\tR\acountry\x12\x1b\n" +
+	"\ttime_zone\x18\t \x01(\tR\btimeZone\x12\x1a\n" +
+	"\bdistrict\x18\n" +
+	" \x01(\tR\bdistrict\x12!\n" +
+	"\tlongitude\x18\v \x01(\x01H\x00R\tlongitude\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\f \x01(\x01H\x01R\blatitude\x88\x01\x01\x12G\n" +
+	"\fday_boundary\x18\r \x01(\x0e2$.trpc.llyb.backend.admin.DayBoundaryR\vdayBoundary\x12&\n" +
+	"\x0fbirth_time_from\x18\x0e \x01(\tR\rbirthTimeFrom\x12\"\n" +
+	"\rbirth_time_to\x18\x0f \x01(\tR\vbirthTimeTo\x12,\n" +
+	"\x12birth_time_unknown\x18\x10 \x01(\bR\x10birthTimeUnknown\x12\x14\n" +
+	"\x05trace\x18\x11 \x01(\bR\x05traceB\f\n" +
+	"\n" +
+	"_longitudeB\v\n" +
+	"\t_latitude\"\xed\x04\n" +
+	"\x11ReasoningResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12#\n" +
+	"\vresult_json\x18\x03 \x01(\tB\x02\x18\x01R\n" +
+	"resultJson\x128\n" +
+	"\x05birth\x18\x04 \x01(\v2\".trpc.llyb.backend.admin.BirthInfoR\x05birth\x12E\n" +
+	"\n" +
+	"solar_time\x18\x05 \x01(\v2&.trpc.llyb.backend.admin.SolarTimeInfoR\tsolarTime\x124\n" +
+	"\x05chart\x18\x06 \x01(\v2\x1e.trpc.llyb.backend.admin.ChartR\x05chart\x12Q\n" +
+	"\rfive_elements\x18\a \x01(\v2,.trpc.llyb.backend.admin.ElementAnalysisInfoR\ffiveElements\x12E\n" +
+	"\n" +
+	"day_master\x18\b \x01(\v2&.trpc.llyb.backend.admin.DayMasterInfoR\tdayMaster\x12S\n" +
+	"\x0fhour_candidates\x18\t \x03(\v2*.trpc.llyb.backend.admin.HourCandidateInfoR\x0ehourCandidates\x12%\n" +
+	"\x0ehour_dependent\x18\n" +
+	" \x03(\tR\rhourDependent\x128\n" +
+	"\x05trace\x18\v \x03(\v2\".trpc.llyb.backend.admin.TraceStepR\x05trace\"\xb6\x01\n" +
+	"\tTraceStep\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12F\n" +
+	"\x06params\x18\x02 \x03(\v2..trpc.llyb.backend.admin.TraceStep.ParamsEntryR\x06params\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x1a9\n" +
+	"\vParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x01\n" +
+	"\rLunarDateInfo\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\"\n" +
+	"\ris_leap_month\x18\x04 \x01(\bR\visLeapMonth\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\"\xdc\x03\n" +
+	"\tBirthInfo\x127\n" +
+	"\x06gender\x18\x01 \x01(\x0e2\x1f.trpc.llyb.backend.admin.GenderR\x06gender\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x1b\n" +
+	"\ttime_zone\x18\x03 \x01(\tR\btimeZone\x12%\n" +
+	"\x0einput_calendar\x18\x04 \x01(\tR\rinputCalendar\x12\x1d\n" +
+	"\n" +
+	"solar_date\x18\x05 \x01(\tR\tsolarDate\x12E\n" +
+	"\n" +
+	"lunar_date\x18\x06 \x01(\v2&.trpc.llyb.backend.admin.LunarDateInfoR\tlunarDate\x12\x1d\n" +
+	"\n" +
+	"birth_time\x18\a \x01(\tR\tbirthTime\x12\x1a\n" +
+	"\bprovince\x18\b \x01(\tR\bprovince\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12\x1a\n" +
+	"\bdistrict\x18\n" +
+	" \x01(\tR\bdistrict\x12\x1b\n" +
+	"\ttime_mode\x18\v \x01(\tR\btimeMode\x12&\n" +
+	"\x0fbirth_time_from\x18\f \x01(\tR\rbirthTimeFrom\x12\"\n" +
+	"\rbirth_time_to\x18\r \x01(\tR\vbirthTimeTo\"\xa2\x02\n" +
+	"\rSolarTimeInfo\x12\x1d\n" +
+	"\n" +
+	"civil_rule\x18\x01 \x01(\tR\tcivilRule\x12\x1d\n" +
+	"\n" +
+	"utc_offset\x18\x02 \x01(\tR\tutcOffset\x12\x10\n" +
+	"\x03dst\x18\x03 \x01(\bR\x03dst\x12!\n" +
+	"\tlongitude\x18\x04 \x01(\x01H\x00R\tlongitude\x88\x01\x01\x12)\n" +
+	"\x10longitude_source\x18\x05 \x01(\tR\x0flongitudeSource\x12'\n" +
+	"\x0flongitude_level\x18\x06 \x01(\tR\x0elongitudeLevel\x12&\n" +
+	"\x0ftrue_solar_time\x18\a \x01(\tR\rtrueSolarTime\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05errorB\f\n" +
+	"\n" +
+	"_longitude\"`\n" +
+	"\x0eHiddenStemInfo\x12\x12\n" +
+	"\x04stem\x18\x01 \x01(\tR\x04stem\x12\x18\n" +
+	"\aelement\x18\x02 \x01(\tR\aelement\x12\x0e\n" +
+	"\x02qi\x18\x03 \x01(\tR\x02qi\x12\x10\n" +
+	"\x03god\x18\x04 \x01(\tR\x03god\"2\n" +
+	"\bStarInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rule\x18\x02 \x01(\tR\x04rule\"\xde\x03\n" +
+	"\x06Pillar\x12\x1a\n" +
+	"\bposition\x18\x01 \x01(\tR\bposition\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\x12\x1c\n" +
+	"\tuncertain\x18\x03 \x01(\bR\tuncertain\x12\x12\n" +
+	"\x04stem\x18\x04 \x01(\tR\x04stem\x12\x16\n" +
+	"\x06branch\x18\x05 \x01(\tR\x06branch\x12!\n" +
+	"\fstem_element\x18\x06 \x01(\tR\vstemElement\x12%\n" +
+	"\x0ebranch_element\x18\a \x01(\tR\rbranchElement\x12\x19\n" +
+	"\bstem_god\x18\b \x01(\tR\astemGod\x12?\n" +
+	"\x06hidden\x18\t \x03(\v2'.trpc.llyb.backend.admin.HiddenStemInfoR\x06hidden\x12\x15\n" +
+	"\x06na_yin\x18\n" +
+	" \x01(\tR\x05naYin\x12\x14\n" +
+	"\x05stage\x18\v \x01(\tR\x05stage\x12\x1d\n" +
+	"\n" +
+	"self_stage\x18\f \x01(\tR\tselfStage\x12\x12\n" +
+	"\x04void\x18\r \x01(\bR\x04void\x12\x1b\n" +
+	"\tyear_void\x18\x0e \x01(\bR\byearVoid\x127\n" +
+	"\x05stars\x18\x0f \x03(\v2!.trpc.llyb.backend.admin.StarInfoR\x05stars\"\xc6\x02\n" +
+	"\x05Chart\x129\n" +
+	"\apillars\x18\x01 \x03(\v2\x1f.trpc.llyb.backend.admin.PillarR\apillars\x12\x12\n" +
+	"\x04bazi\x18\x02 \x01(\tR\x04bazi\x12!\n" +
+	"\fday_boundary\x18\x03 \x01(\tR\vdayBoundary\x12,\n" +
+	"\x12day_boundary_label\x18\x04 \x01(\tR\x10dayBoundaryLabel\x12\x17\n" +
+	"\alate_zi\x18\x05 \x01(\bR\x06lateZi\x12L\n" +
+	"\finteractions\x18\x06 \x03(\v2(.trpc.llyb.backend.admin.InteractionInfoR\finteractions\x12\x19\n" +
+	"\bday_void\x18\a \x01(\tR\adayVoid\x12\x1b\n" +
+	"\tyear_void\x18\b \x01(\tR\byearVoid\"\x85\x01\n" +
+	"\rElementScores\x12\x18\n" +
+	"\aelement\x18\x01 \x01(\tR\aelement\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12\x18\n" +
+	"\apercent\x18\x03 \x01(\x01R\apercent\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x05R\x05count\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\"\x8b\x01\n" +
+	"\x13ElementAnalysisInfo\x12B\n" +
+	"\belements\x18\x01 \x03(\v2&.trpc.llyb.backend.admin.ElementScoresR\belements\x12\x18\n" +
+	"\amissing\x18\x02 \x03(\tR\amissing\x12\x16\n" +
+	"\x06season\x18\x03 \x01(\tR\x06season\"\x87\x02\n" +
+	"\rDayMasterInfo\x12\x12\n" +
+	"\x04stem\x18\x01 \x01(\tR\x04stem\x12\x18\n" +
+	"\aelement\x18\x02 \x01(\tR\aelement\x12\x1a\n" +
+	"\bstrength\x18\x03 \x01(\tR\bstrength\x12#\n" +
+	"\rsupport_ratio\x18\x04 \x01(\x01R\fsupportRatio\x12\x17\n" +
+	"\ade_ling\x18\x05 \x01(\bR\x06deLing\x12\x13\n" +
+	"\x05de_di\x18\x06 \x01(\bR\x04deDi\x12\x15\n" +
+	"\x06de_shi\x18\a \x01(\bR\x05deShi\x12\x1e\n" +
+	"\n" +
+	"favourable\x18\b \x03(\tR\n" +
+	"favourable\x12\"\n" +
+	"\funfavourable\x18\t \x03(\tR\funfavourable\"\x8a\x02\n" +
+	"\x11HourCandidateInfo\x12\x12\n" +
+	"\x04bazi\x18\x01 \x01(\tR\x04bazi\x12\x1f\n" +
+	"\vhour_pillar\x18\x02 \x01(\tR\n" +
+	"hourPillar\x12\x1d\n" +
+	"\n" +
+	"civil_from\x18\x03 \x01(\tR\tcivilFrom\x12\x19\n" +
+	"\bcivil_to\x18\x04 \x01(\tR\acivilTo\x12&\n" +
+	"\x0ftrue_solar_from\x18\x05 \x01(\tR\rtrueSolarFrom\x12\"\n" +
+	"\rtrue_solar_to\x18\x06 \x01(\tR\vtrueSolarTo\x12\x1a\n" +
+	"\bstrength\x18\a \x01(\tR\bstrength\x12\x1e\n" +
+	"\n" +
+	"favourable\x18\b \x03(\tR\n" +
+	"favourable\"'\n" +
+	"\x11SolarTermsRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"\x9d\x01\n" +
+	"\rSolarTermInfo\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rlongitude_deg\x18\x03 \x01(\x01R\flongitudeDeg\x12\x15\n" +
+	"\x06is_jie\x18\x04 \x01(\bR\x05isJie\x12\x12\n" +
+	"\x04time\x18\x05 \x01(\tR\x04time\x12\x12\n" +
+	"\x04unix\x18\x06 \x01(\x03R\x04unix\"\x94\x01\n" +
+	"\x12SolarTermsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12<\n" +
+	"\x05terms\x18\x04 \x03(\v2&.trpc.llyb.backend.admin.SolarTermInfoR\x05terms\"`\n" +
+	"\x13GeoCacheListRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xd2\x01\n" +
+	"\rGeoCacheEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\blatitude\x18\x03 \x01(\x01R\blatitude\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x1b\n" +
+	"\tin_memory\x18\a \x01(\bR\binMemory\"\x87\x02\n" +
+	"\x14GeoCacheListResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12@\n" +
+	"\aentries\x18\x04 \x03(\v2&.trpc.llyb.backend.admin.GeoCacheEntryR\aentries\x12\x1f\n" +
+	"\vmemory_size\x18\x05 \x01(\x05R\n" +
+	"memorySize\x12'\n" +
+	"\x0fmemory_capacity\x18\x06 \x01(\x05R\x0ememoryCapacity\x12\x1f\n" +
+	"\vttl_seconds\x18\a \x01(\x03R\n" +
+	"ttlSeconds\"]\n" +
+	"\x14GeoCachePurgeRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\fexpired_only\x18\x02 \x01(\bR\vexpiredOnly\x12\x10\n" +
+	"\x03all\x18\x03 \x01(\bR\x03all\"]\n" +
+	"\x15GeoCachePurgeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06purged\x18\x03 \x01(\x03R\x06purged\"\xbd\x01\n" +
+	"\x0fTimelineRequest\x12?\n" +
+	"\x05birth\x18\x01 \x01(\v2).trpc.llyb.backend.admin.ReasoningRequestR\x05birth\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"start_year\x18\x04 \x01(\x05R\tstartYear\x12\x19\n" +
+	"\bend_year\x18\x05 \x01(\x05R\aendYear\"q\n" +
+	"\x0fInteractionInfo\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tpositions\x18\x03 \x03(\tR\tpositions\x12\x18\n" +
+	"\aelement\x18\x04 \x01(\tR\aelement\"\xf9\x01\n" +
+	"\x10AnnualPillarInfo\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x16\n" +
+	"\x06pillar\x18\x02 \x01(\tR\x06pillar\x12\x10\n" +
+	"\x03age\x18\x03 \x01(\x05R\x03age\x12\x19\n" +
+	"\bstem_god\x18\x04 \x01(\tR\astemGod\x12\x1d\n" +
+	"\n" +
+	"branch_god\x18\x05 \x01(\tR\tbranchGod\x12L\n" +
+	"\finteractions\x18\x06 \x03(\v2(.trpc.llyb.backend.admin.InteractionInfoR\finteractions\x12\x1f\n" +
+	"\vluck_pillar\x18\a \x01(\tR\n" +
+	"luckPillar\"\xff\x02\n" +
+	"\x0eLuckPillarInfo\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x16\n" +
+	"\x06pillar\x18\x02 \x01(\tR\x06pillar\x12\x19\n" +
+	"\bstem_god\x18\x03 \x01(\tR\astemGod\x12\x1d\n" +
+	"\n" +
+	"branch_god\x18\x04 \x01(\tR\tbranchGod\x12\x1b\n" +
+	"\tstart_age\x18\x05 \x01(\x05R\bstartAge\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x06 \x01(\tR\tstartDate\x12\x1d\n" +
+	"\n" +
+	"start_year\x18\a \x01(\x05R\tstartYear\x12\x19\n" +
+	"\bend_year\x18\b \x01(\x05R\aendYear\x12A\n" +
+	"\x06annual\x18\t \x03(\v2).trpc.llyb.backend.admin.AnnualPillarInfoR\x06annual\x12L\n" +
+	"\finteractions\x18\n" +
+	" \x03(\v2(.trpc.llyb.backend.admin.InteractionInfoR\finteractions\"\xb1\x04\n" +
+	"\x10TimelineResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04bazi\x18\x03 \x01(\tR\x04bazi\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x1f\n" +
+	"\vstart_years\x18\x05 \x01(\x05R\n" +
+	"startYears\x12!\n" +
+	"\fstart_months\x18\x06 \x01(\x05R\vstartMonths\x12\x1d\n" +
+	"\n" +
+	"start_days\x18\a \x01(\x05R\tstartDays\x12\x19\n" +
+	"\bjie_name\x18\b \x01(\tR\ajieName\x12\x19\n" +
+	"\bjie_time\x18\t \x01(\tR\ajieTime\x12\x12\n" +
+	"\x04page\x18\n" +
+	" \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\v \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vtotal_pages\x18\f \x01(\x05R\n" +
+	"totalPages\x12J\n" +
+	"\fluck_pillars\x18\r \x03(\v2'.trpc.llyb.backend.admin.LuckPillarInfoR\vluckPillars\x12!\n" +
+	"\fday_boundary\x18\x0e \x01(\tR\vdayBoundary\x12 \n" +
+	"\vapproximate\x18\x0f \x01(\bR\vapproximate\x12A\n" +
+	"\x06annual\x18\x10 \x03(\v2).trpc.llyb.backend.admin.AnnualPillarInfoR\x06annual\"\x9a\x01\n" +
+	"\x14CompatibilityRequest\x12?\n" +
+	"\x05first\x18\x01 \x01(\v2).trpc.llyb.backend.admin.ReasoningRequestR\x05first\x12A\n" +
+	"\x06second\x18\x02 \x01(\v2).trpc.llyb.backend.admin.ReasoningRequestR\x06second\"\x88\x02\n" +
+	"\fChartSummary\x12\x12\n" +
+	"\x04bazi\x18\x01 \x01(\tR\x04bazi\x12\x1d\n" +
+	"\n" +
+	"day_master\x18\x02 \x01(\tR\tdayMaster\x12,\n" +
+	"\x12day_master_element\x18\x03 \x01(\tR\x10dayMasterElement\x12\x1a\n" +
+	"\bstrength\x18\x04 \x01(\tR\bstrength\x12\x1e\n" +
+	"\n" +
+	"favourable\x18\x05 \x03(\tR\n" +
+	"favourable\x12\x16\n" +
+	"\x06zodiac\x18\x06 \x01(\tR\x06zodiac\x12!\n" +
+	"\fday_boundary\x18\a \x01(\tR\vdayBoundary\x12 \n" +
+	"\vapproximate\x18\b \x01(\bR\vapproximate\"u\n" +
+	"\x13CompatibilityAspect\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\"\xa8\x01\n" +
+	"\x14CrossInteractionInfo\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\x0efirst_position\x18\x03 \x01(\tR\rfirstPosition\x12'\n" +
+	"\x0fsecond_position\x18\x04 \x01(\tR\x0esecondPosition\x12\x18\n" +
+	"\aelement\x18\x05 \x01(\tR\aelement\"\x88\x03\n" +
+	"\x15CompatibilityResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x05first\x18\x03 \x01(\v2%.trpc.llyb.backend.admin.ChartSummaryR\x05first\x12=\n" +
+	"\x06second\x18\x04 \x01(\v2%.trpc.llyb.backend.admin.ChartSummaryR\x06second\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x14\n" +
+	"\x05level\x18\x06 \x01(\tR\x05level\x12F\n" +
+	"\aaspects\x18\a \x03(\v2,.trpc.llyb.backend.admin.CompatibilityAspectR\aaspects\x12Q\n" +
+	"\finteractions\x18\b \x03(\v2-.trpc.llyb.backend.admin.CrossInteractionInfoR\finteractions\"\xe4\x02\n" +
+	"\x14ReverseLookupRequest\x12\x1f\n" +
+	"\vyear_pillar\x18\x01 \x01(\tR\n" +
+	"yearPillar\x12!\n" +
+	"\fmonth_pillar\x18\x02 \x01(\tR\vmonthPillar\x12\x1d\n" +
+	"\n" +
+	"day_pillar\x18\x03 \x01(\tR\tdayPillar\x12\x1f\n" +
+	"\vhour_pillar\x18\x04 \x01(\tR\n" +
+	"hourPillar\x12\x1d\n" +
+	"\n" +
+	"start_year\x18\x05 \x01(\x05R\tstartYear\x12\x19\n" +
+	"\bend_year\x18\x06 \x01(\x05R\aendYear\x12G\n" +
+	"\fday_boundary\x18\a \x01(\x0e2$.trpc.llyb.backend.admin.DayBoundaryR\vdayBoundary\x12!\n" +
+	"\tlongitude\x18\b \x01(\x01H\x00R\tlongitude\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\t \x01(\x05R\x05limitB\f\n" +
+	"\n" +
+	"_longitude\"\xd6\x01\n" +
+	"\x0eDatetimeWindow\x12\x12\n" +
+	"\x04bazi\x18\x01 \x01(\tR\x04bazi\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12\x1d\n" +
+	"\n" +
+	"start_unix\x18\x04 \x01(\x03R\tstartUnix\x12\x19\n" +
+	"\bend_unix\x18\x05 \x01(\x03R\aendUnix\x12(\n" +
+	"\x10true_solar_start\x18\x06 \x01(\tR\x0etrueSolarStart\x12$\n" +
+	"\x0etrue_solar_end\x18\a \x01(\tR\ftrueSolarEnd\"\xc9\x01\n" +
+	"\x15ReverseLookupResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12A\n" +
+	"\awindows\x18\x03 \x03(\v2'.trpc.llyb.backend.admin.DatetimeWindowR\awindows\x12\x1c\n" +
+	"\ttruncated\x18\x04 \x01(\bR\ttruncated\x12!\n" +
+	"\fday_boundary\x18\x05 \x01(\tR\vdayBoundary\";\n" +
+	"\x0fCalendarRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\"\xd1\x03\n" +
+	"\vCalendarDay\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x18\n" +
+	"\aweekday\x18\x02 \x01(\x05R\aweekday\x12\x19\n" +
+	"\bin_month\x18\x03 \x01(\bR\ainMonth\x12\x1d\n" +
+	"\n" +
+	"lunar_year\x18\x04 \x01(\x05R\tlunarYear\x12\x1f\n" +
+	"\vlunar_month\x18\x05 \x01(\x05R\n" +
+	"lunarMonth\x12\x1b\n" +
+	"\tlunar_day\x18\x06 \x01(\x05R\blunarDay\x12\"\n" +
+	"\ris_leap_month\x18\a \x01(\bR\visLeapMonth\x12(\n" +
+	"\x10lunar_month_name\x18\b \x01(\tR\x0elunarMonthName\x12$\n" +
+	"\x0elunar_day_name\x18\t \x01(\tR\flunarDayName\x12\x1f\n" +
+	"\vyear_pillar\x18\n" +
+	" \x01(\tR\n" +
+	"yearPillar\x12!\n" +
+	"\fmonth_pillar\x18\v \x01(\tR\vmonthPillar\x12\x1d\n" +
+	"\n" +
+	"day_pillar\x18\f \x01(\tR\tdayPillar\x12E\n" +
+	"\n" +
+	"solar_term\x18\r \x01(\v2&.trpc.llyb.backend.admin.SolarTermInfoR\tsolarTerm\"\xb8\x01\n" +
+	"\x10CalendarResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x12\n" +
+	"\x04year\x18\x03 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x04 \x01(\x05R\x05month\x128\n" +
+	"\x04days\x18\x05 \x03(\v2$.trpc.llyb.backend.admin.CalendarDayR\x04days\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\"'\n" +
+	"\x11AlmanacDayRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"\xf1\x02\n" +
+	"\x12AlmanacDayResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\x03day\x18\x03 \x01(\v2$.trpc.llyb.backend.admin.CalendarDayR\x03day\x12\x18\n" +
+	"\aofficer\x18\x04 \x01(\tR\aofficer\x12\x18\n" +
+	"\amansion\x18\x05 \x01(\tR\amansion\x12-\n" +
+	"\x12mansion_auspicious\x18\x06 \x01(\bR\x11mansionAuspicious\x12\x17\n" +
+	"\apeng_zu\x18\a \x03(\tR\x06pengZu\x12\x14\n" +
+	"\x05clash\x18\b \x01(\tR\x05clash\x12\x10\n" +
+	"\x03sha\x18\t \x01(\tR\x03sha\x12\x1d\n" +
+	"\n" +
+	"year_clash\x18\n" +
+	" \x01(\bR\tyearClash\x12\x0e\n" +
+	"\x02yi\x18\v \x03(\tR\x02yi\x12\x0e\n" +
+	"\x02ji\x18\f \x03(\tR\x02ji\x12\x12\n" +
+	"\x04etag\x18\r \x01(\tR\x04etag\"\xd1\x01\n" +
+	"\x14DateSelectionRequest\x12\x1a\n" +
+	"\bactivity\x18\x01 \x01(\tR\bactivity\x12M\n" +
+	"\fparticipants\x18\x02 \x03(\v2).trpc.llyb.backend.admin.ReasoningRequestR\fparticipants\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x04 \x01(\tR\aendDate\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"6\n" +
+	"\n" +
+	"DateReason\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\"\xe7\x01\n" +
+	"\rDateCandidate\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x1d\n" +
+	"\n" +
+	"day_pillar\x18\x02 \x01(\tR\tdayPillar\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\x12\x1a\n" +
+	"\bsuitable\x18\x04 \x01(\bR\bsuitable\x12\x18\n" +
+	"\aofficer\x18\x05 \x01(\tR\aofficer\x12\x18\n" +
+	"\amansion\x18\x06 \x01(\tR\amansion\x12=\n" +
+	"\areasons\x18\a \x03(\v2#.trpc.llyb.backend.admin.DateReasonR\areasons\"\xbf\x01\n" +
+	"\x15DateSelectionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\n" +
+	"activities\x18\x03 \x03(\tR\n" +
+	"activities\x12:\n" +
+	"\x04days\x18\x04 \x03(\v2&.trpc.llyb.backend.admin.DateCandidateR\x04days\x12\x1c\n" +
+	"\tevaluated\x18\x05 \x01(\x05R\tevaluated\"T\n" +
+	"\x11ZiweiChartRequest\x12?\n" +
+	"\x05birth\x18\x01 \x01(\v2).trpc.llyb.backend.admin.ReasoningRequestR\x05birth\"s\n" +
+	"\tZiweiStar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05major\x18\x02 \x01(\bR\x05major\x12\x1e\n" +
+	"\n" +
+	"brightness\x18\x03 \x01(\tR\n" +
+	"brightness\x12\x1c\n" +
+	"\ttransform\x18\x04 \x01(\tR\ttransform\"\xd9\x01\n" +
+	"\vZiweiPalace\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06branch\x18\x02 \x01(\tR\x06branch\x12\x12\n" +
+	"\x04stem\x18\x03 \x01(\tR\x04stem\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\bR\x04body\x128\n" +
+	"\x05stars\x18\x05 \x03(\v2\".trpc.llyb.backend.admin.ZiweiStarR\x05stars\x12\x1f\n" +
+	"\vdecade_from\x18\x06 \x01(\x05R\n" +
+	"decadeFrom\x12\x1b\n" +
+	"\tdecade_to\x18\a \x01(\x05R\bdecadeTo\"\xa3\x03\n" +
+	"\n" +
+	"ZiweiPlate\x12E\n" +
+	"\n" +
+	"lunar_date\x18\x01 \x01(\v2&.trpc.llyb.backend.admin.LunarDateInfoR\tlunarDate\x12\x1f\n" +
+	"\vyear_pillar\x18\x02 \x01(\tR\n" +
+	"yearPillar\x12\x12\n" +
+	"\x04hour\x18\x03 \x01(\tR\x04hour\x12\x1f\n" +
+	"\vlife_branch\x18\x04 \x01(\tR\n" +
+	"lifeBranch\x12\x1f\n" +
+	"\vbody_branch\x18\x05 \x01(\tR\n" +
+	"bodyBranch\x12\x1f\n" +
+	"\vbody_palace\x18\x06 \x01(\tR\n" +
+	"bodyPalace\x12\x16\n" +
+	"\x06bureau\x18\a \x01(\tR\x06bureau\x12\x1f\n" +
+	"\vlife_master\x18\b \x01(\tR\n" +
+	"lifeMaster\x12\x1f\n" +
+	"\vbody_master\x18\t \x01(\tR\n" +
+	"bodyMaster\x12\x1c\n" +
+	"\tdirection\x18\n" +
+	" \x01(\tR\tdirection\x12>\n" +
+	"\apalaces\x18\v \x03(\v2$.trpc.llyb.backend.admin.ZiweiPalaceR\apalaces\"\x81\x01\n" +
+	"\x0eZiweiCandidate\x12\x1b\n" +
+	"\ttime_from\x18\x01 \x01(\tR\btimeFrom\x12\x17\n" +
+	"\atime_to\x18\x02 \x01(\tR\x06timeTo\x129\n" +
+	"\x05chart\x18\x03 \x01(\v2#.trpc.llyb.backend.admin.ZiweiPlateR\x05chart\"\xaf\x02\n" +
+	"\x12ZiweiChartResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12E\n" +
+	"\n" +
+	"solar_time\x18\x03 \x01(\v2&.trpc.llyb.backend.admin.SolarTimeInfoR\tsolarTime\x129\n" +
+	"\x05chart\x18\x04 \x01(\v2#.trpc.llyb.backend.admin.ZiweiPlateR\x05chart\x12G\n" +
+	"\n" +
+	"candidates\x18\x05 \x03(\v2'.trpc.llyb.backend.admin.ZiweiCandidateR\n" +
+	"candidates\x12 \n" +
+	"\vapproximate\x18\x06 \x01(\bR\vapproximate*D\n" +
+	"\x06Gender\x12\x16\n" +
+	"\x12GENDER_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vGENDER_MALE\x10\x01\x12\x11\n" +
+	"\rGENDER_FEMALE\x10\x02*~\n" +
+	"\vDayBoundary\x12\x1c\n" +
+	"\x18DAY_BOUNDARY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DAY_BOUNDARY_ZI_INITIAL\x10\x01\x12\x19\n" +
+	"\x15DAY_BOUNDARY_SPLIT_ZI\x10\x02\x12\x19\n" +
+	"\x15DAY_BOUNDARY_MIDNIGHT\x10\x032\xf4\f\n" +
+	"\x05Admin\x12h\n" +
+	"\x05Login\x12%.trpc.llyb.backend.admin.LoginRequest\x1a&.trpc.llyb.backend.admin.LoginResponse\"\x10\x8a\xb5\x18\f/admin/login\x12t\n" +
+	"\bRegister\x12(.trpc.llyb.backend.admin.RegisterRequest\x1a).trpc.llyb.backend.admin.RegisterResponse\"\x13\x8a\xb5\x18\x0f/admin/register\x12x\n" +
+	"\tReasoning\x12).trpc.llyb.backend.admin.ReasoningRequest\x1a*.trpc.llyb.backend.admin.ReasoningResponse\"\x14\x8a\xb5\x18\x10/admin/reasoning\x12|\n" +
+	"\n" +
+	"SolarTerms\x12*.trpc.llyb.backend.admin.SolarTermsRequest\x1a+.trpc.llyb.backend.admin.SolarTermsResponse\"\x15\x8a\xb5\x18\x11/bazi/solar-terms\x12\x86\x01\n" +
+	"\fGeoCacheList\x12,.trpc.llyb.backend.admin.GeoCacheListRequest\x1a-.trpc.llyb.backend.admin.GeoCacheListResponse\"\x19\x8a\xb5\x18\x15/admin/geo-cache/list\x12\x8a\x01\n" +
+	"\rGeoCachePurge\x12-.trpc.llyb.backend.admin.GeoCachePurgeRequest\x1a..trpc.llyb.backend.admin.GeoCachePurgeResponse\"\x1a\x8a\xb5\x18\x16/admin/geo-cache/purge\x12s\n" +
+	"\bTimeline\x12(.trpc.llyb.backend.admin.TimelineRequest\x1a).trpc.llyb.backend.admin.TimelineResponse\"\x12\x8a\xb5\x18\x0e/bazi/timeline\x12\x87\x01\n" +
+	"\rCompatibility\x12-.trpc.llyb.backend.admin.CompatibilityRequest\x1a..trpc.llyb.backend.admin.CompatibilityResponse\"\x17\x8a\xb5\x18\x13/bazi/compatibility\x12\x88\x01\n" +
+	"\rReverseLookup\x12-.trpc.llyb.backend.admin.ReverseLookupRequest\x1a..trpc.llyb.backend.admin.ReverseLookupResponse\"\x18\x8a\xb5\x18\x14/bazi/reverse-lookup\x12s\n" +
+	"\bCalendar\x12(.trpc.llyb.backend.admin.CalendarRequest\x1a).trpc.llyb.backend.admin.CalendarResponse\"\x12\x8a\xb5\x18\x0e/bazi/calendar\x12w\n" +
+	"\n" +
+	"AlmanacDay\x12*.trpc.llyb.backend.admin.AlmanacDayRequest\x1a+.trpc.llyb.backend.admin.AlmanacDayResponse\"\x10\x8a\xb5\x18\f/almanac/day\x12\x8b\x01\n" +
+	"\rDateSelection\x12-.trpc.llyb.backend.admin.DateSelectionRequest\x1a..trpc.llyb.backend.admin.DateSelectionResponse\"\x1b\x8a\xb5\x18\x17/almanac/date-selection\x12w\n" +
+	"\n" +
+	"ZiweiChart\x12*.trpc.llyb.backend.admin.ZiweiChartRequest\x1a+.trpc.llyb.backend.admin.ZiweiChartResponse\"\x10\x8a\xb5\x18\f/ziwei/chartB\x1aZ\x18llyb-backend/proto;protob\x06proto3"
 
 var (
 	file_admin_proto_rawDescOnce sync.Once
@@ -486,30 +5433,146 @@ func file_admin_proto_rawDescGZIP() []byte {
 	return file_admin_proto_rawDescData
 }
 
-var file_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 55)
 var file_admin_proto_goTypes = []any{
-	(Gender)(0),               // 0: trpc.llyb.backend.admin.Gender
-	(*LoginRequest)(nil),      // 1: trpc.llyb.backend.admin.LoginRequest
-	(*LoginResponse)(nil),     // 2: trpc.llyb.backend.admin.LoginResponse
-	(*RegisterRequest)(nil),   // 3: trpc.llyb.backend.admin.RegisterRequest
-	(*RegisterResponse)(nil),  // 4: trpc.llyb.backend.admin.RegisterResponse
-	(*ReasoningRequest)(nil),  // 5: trpc.llyb.backend.admin.ReasoningRequest
-	(*ReasoningResponse)(nil), // 6: trpc.llyb.backend.admin.ReasoningResponse
+	(Gender)(0),                   // 0: trpc.llyb.backend.admin.Gender
+	(DayBoundary)(0),              // 1: trpc.llyb.backend.admin.DayBoundary
+	(*LoginRequest)(nil),          // 2: trpc.llyb.backend.admin.LoginRequest
+	(*LoginResponse)(nil),         // 3: trpc.llyb.backend.admin.LoginResponse
+	(*RegisterRequest)(nil),       // 4: trpc.llyb.backend.admin.RegisterRequest
+	(*RegisterResponse)(nil),      // 5: trpc.llyb.backend.admin.RegisterResponse
+	(*ReasoningRequest)(nil),      // 6: trpc.llyb.backend.admin.ReasoningRequest
+	(*ReasoningResponse)(nil),     // 7: trpc.llyb.backend.admin.ReasoningResponse
+	(*TraceStep)(nil),             // 8: trpc.llyb.backend.admin.TraceStep
+	(*LunarDateInfo)(nil),         // 9: trpc.llyb.backend.admin.LunarDateInfo
+	(*BirthInfo)(nil),             // 10: trpc.llyb.backend.admin.BirthInfo
+	(*SolarTimeInfo)(nil),         // 11: trpc.llyb.backend.admin.SolarTimeInfo
+	(*HiddenStemInfo)(nil),        // 12: trpc.llyb.backend.admin.HiddenStemInfo
+	(*StarInfo)(nil),              // 13: trpc.llyb.backend.admin.StarInfo
+	(*Pillar)(nil),                // 14: trpc.llyb.backend.admin.Pillar
+	(*Chart)(nil),                 // 15: trpc.llyb.backend.admin.Chart
+	(*ElementScores)(nil),         // 16: trpc.llyb.backend.admin.ElementScores
+	(*ElementAnalysisInfo)(nil),   // 17: trpc.llyb.backend.admin.ElementAnalysisInfo
+	(*DayMasterInfo)(nil),         // 18: trpc.llyb.backend.admin.DayMasterInfo
+	(*HourCandidateInfo)(nil),     // 19: trpc.llyb.backend.admin.HourCandidateInfo
+	(*SolarTermsRequest)(nil),     // 20: trpc.llyb.backend.admin.SolarTermsRequest
+	(*SolarTermInfo)(nil),         // 21: trpc.llyb.backend.admin.SolarTermInfo
+	(*SolarTermsResponse)(nil),    // 22: trpc.llyb.backend.admin.SolarTermsResponse
+	(*GeoCacheListRequest)(nil),   // 23: trpc.llyb.backend.admin.GeoCacheListRequest
+	(*GeoCacheEntry)(nil),         // 24: trpc.llyb.backend.admin.GeoCacheEntry
+	(*GeoCacheListResponse)(nil),  // 25: trpc.llyb.backend.admin.GeoCacheListResponse
+	(*GeoCachePurgeRequest)(nil),  // 26: trpc.llyb.backend.admin.GeoCachePurgeRequest
+	(*GeoCachePurgeResponse)(nil), // 27: trpc.llyb.backend.admin.GeoCachePurgeResponse
+	(*TimelineRequest)(nil),       // 28: trpc.llyb.backend.admin.TimelineRequest
+	(*InteractionInfo)(nil),       // 29: trpc.llyb.backend.admin.InteractionInfo
+	(*AnnualPillarInfo)(nil),      // 30: trpc.llyb.backend.admin.AnnualPillarInfo
+	(*LuckPillarInfo)(nil),        // 31: trpc.llyb.backend.admin.LuckPillarInfo
+	(*TimelineResponse)(nil),      // 32: trpc.llyb.backend.admin.TimelineResponse
+	(*CompatibilityRequest)(nil),  // 33: trpc.llyb.backend.admin.CompatibilityRequest
+	(*ChartSummary)(nil),          // 34: trpc.llyb.backend.admin.ChartSummary
+	(*CompatibilityAspect)(nil),   // 35: trpc.llyb.backend.admin.CompatibilityAspect
+	(*CrossInteractionInfo)(nil),  // 36: trpc.llyb.backend.admin.CrossInteractionInfo
+	(*CompatibilityResponse)(nil), // 37: trpc.llyb.backend.admin.CompatibilityResponse
+	(*ReverseLookupRequest)(nil),  // 38: trpc.llyb.backend.admin.ReverseLookupRequest
+	(*DatetimeWindow)(nil),        // 39: trpc.llyb.backend.admin.DatetimeWindow
+	(*ReverseLookupResponse)(nil), // 40: trpc.llyb.backend.admin.ReverseLookupResponse
+	(*CalendarRequest)(nil),       // 41: trpc.llyb.backend.admin.CalendarRequest
+	(*CalendarDay)(nil),           // 42: trpc.llyb.backend.admin.CalendarDay
+	(*CalendarResponse)(nil),      // 43: trpc.llyb.backend.admin.CalendarResponse
+	(*AlmanacDayRequest)(nil),     // 44: trpc.llyb.backend.admin.AlmanacDayRequest
+	(*AlmanacDayResponse)(nil),    // 45: trpc.llyb.backend.admin.AlmanacDayResponse
+	(*DateSelectionRequest)(nil),  // 46: trpc.llyb.backend.admin.DateSelectionRequest
+	(*DateReason)(nil),            // 47: trpc.llyb.backend.admin.DateReason
+	(*DateCandidate)(nil),         // 48: trpc.llyb.backend.admin.DateCandidate
+	(*DateSelectionResponse)(nil), // 49: trpc.llyb.backend.admin.DateSelectionResponse
+	(*ZiweiChartRequest)(nil),     // 50: trpc.llyb.backend.admin.ZiweiChartRequest
+	(*ZiweiStar)(nil),             // 51: trpc.llyb.backend.admin.ZiweiStar
+	(*ZiweiPalace)(nil),           // 52: trpc.llyb.backend.admin.ZiweiPalace
+	(*ZiweiPlate)(nil),            // 53: trpc.llyb.backend.admin.ZiweiPlate
+	(*ZiweiCandidate)(nil),        // 54: trpc.llyb.backend.admin.ZiweiCandidate
+	(*ZiweiChartResponse)(nil),    // 55: trpc.llyb.backend.admin.ZiweiChartResponse
+	nil,                           // 56: trpc.llyb.backend.admin.TraceStep.ParamsEntry
 }
 var file_admin_proto_depIdxs = []int32{
-	0, // 0: trpc.llyb.backend.admin.ReasoningRequest.gender:type_name -> trpc.llyb.backend.admin.Gender
-	1, // 1: trpc.llyb.backend.admin.Admin.Login:input_type -> trpc.llyb.backend.admin.LoginRequest
-	3, // 2: trpc.llyb.backend.admin.Admin.Register:input_type -> trpc.llyb.backend.admin.RegisterRequest
-	5, // 3: trpc.llyb.backend.admin.Admin.Reasoning:input_type -> trpc.llyb.backend.admin.ReasoningRequest
-	2, // 4: trpc.llyb.backend.admin.Admin.Login:output_type -> trpc.llyb.backend.admin.LoginResponse
-	4, // 5: trpc.llyb.backend.admin.Admin.Register:output_type -> trpc.llyb.backend.admin.RegisterResponse
-	6, // 6: trpc.llyb.backend.admin.Admin.Reasoning:output_type -> trpc.llyb.backend.admin.ReasoningResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: trpc.llyb.backend.admin.ReasoningRequest.gender:type_name -> trpc.llyb.backend.admin.Gender
+	1,  // 1: trpc.llyb.backend.admin.ReasoningRequest.day_boundary:type_name -> trpc.llyb.backend.admin.DayBoundary
+	10, // 2: trpc.llyb.backend.admin.ReasoningResponse.birth:type_name -> trpc.llyb.backend.admin.BirthInfo
+	11, // 3: trpc.llyb.backend.admin.ReasoningResponse.solar_time:type_name -> trpc.llyb.backend.admin.SolarTimeInfo
+	15, // 4: trpc.llyb.backend.admin.ReasoningResponse.chart:type_name -> trpc.llyb.backend.admin.Chart
+	17, // 5: trpc.llyb.backend.admin.ReasoningResponse.five_elements:type_name -> trpc.llyb.backend.admin.ElementAnalysisInfo
+	18, // 6: trpc.llyb.backend.admin.ReasoningResponse.day_master:type_name -> trpc.llyb.backend.admin.DayMasterInfo
+	19, // 7: trpc.llyb.backend.admin.ReasoningResponse.hour_candidates:type_name -> trpc.llyb.backend.admin.HourCandidateInfo
+	8,  // 8: trpc.llyb.backend.admin.ReasoningResponse.trace:type_name -> trpc.llyb.backend.admin.TraceStep
+	56, // 9: trpc.llyb.backend.admin.TraceStep.params:type_name -> trpc.llyb.backend.admin.TraceStep.ParamsEntry
+	0,  // 10: trpc.llyb.backend.admin.BirthInfo.gender:type_name -> trpc.llyb.backend.admin.Gender
+	9,  // 11: trpc.llyb.backend.admin.BirthInfo.lunar_date:type_name -> trpc.llyb.backend.admin.LunarDateInfo
+	12, // 12: trpc.llyb.backend.admin.Pillar.hidden:type_name -> trpc.llyb.backend.admin.HiddenStemInfo
+	13, // 13: trpc.llyb.backend.admin.Pillar.stars:type_name -> trpc.llyb.backend.admin.StarInfo
+	14, // 14: trpc.llyb.backend.admin.Chart.pillars:type_name -> trpc.llyb.backend.admin.Pillar
+	29, // 15: trpc.llyb.backend.admin.Chart.interactions:type_name -> trpc.llyb.backend.admin.InteractionInfo
+	16, // 16: trpc.llyb.backend.admin.ElementAnalysisInfo.elements:type_name -> trpc.llyb.backend.admin.ElementScores
+	21, // 17: trpc.llyb.backend.admin.SolarTermsResponse.terms:type_name -> trpc.llyb.backend.admin.SolarTermInfo
+	24, // 18: trpc.llyb.backend.admin.GeoCacheListResponse.entries:type_name -> trpc.llyb.backend.admin.GeoCacheEntry
+	6,  // 19: trpc.llyb.backend.admin.TimelineRequest.birth:type_name -> trpc.llyb.backend.admin.ReasoningRequest
+	29, // 20: trpc.llyb.backend.admin.AnnualPillarInfo.interactions:type_name -> trpc.llyb.backend.admin.InteractionInfo
+	30, // 21: trpc.llyb.backend.admin.LuckPillarInfo.annual:type_name -> trpc.llyb.backend.admin.AnnualPillarInfo
+	29, // 22: trpc.llyb.backend.admin.LuckPillarInfo.interactions:type_name -> trpc.llyb.backend.admin.InteractionInfo
+	31, // 23: trpc.llyb.backend.admin.TimelineResponse.luck_pillars:type_name -> trpc.llyb.backend.admin.LuckPillarInfo
+	30, // 24: trpc.llyb.backend.admin.TimelineResponse.annual:type_name -> trpc.llyb.backend.admin.AnnualPillarInfo
+	6,  // 25: trpc.llyb.backend.admin.CompatibilityRequest.first:type_name -> trpc.llyb.backend.admin.ReasoningRequest
+	6,  // 26: trpc.llyb.backend.admin.CompatibilityRequest.second:type_name -> trpc.llyb.backend.admin.ReasoningRequest
+	34, // 27: trpc.llyb.backend.admin.CompatibilityResponse.first:type_name -> trpc.llyb.backend.admin.ChartSummary
+	34, // 28: trpc.llyb.backend.admin.CompatibilityResponse.second:type_name -> trpc.llyb.backend.admin.ChartSummary
+	35, // 29: trpc.llyb.backend.admin.CompatibilityResponse.aspects:type_name -> trpc.llyb.backend.admin.CompatibilityAspect
+	36, // 30: trpc.llyb.backend.admin.CompatibilityResponse.interactions:type_name -> trpc.llyb.backend.admin.CrossInteractionInfo
+	1,  // 31: trpc.llyb.backend.admin.ReverseLookupRequest.day_boundary:type_name -> trpc.llyb.backend.admin.DayBoundary
+	39, // 32: trpc.llyb.backend.admin.ReverseLookupResponse.windows:type_name -> trpc.llyb.backend.admin.DatetimeWindow
+	21, // 33: trpc.llyb.backend.admin.CalendarDay.solar_term:type_name -> trpc.llyb.backend.admin.SolarTermInfo
+	42, // 34: trpc.llyb.backend.admin.CalendarResponse.days:type_name -> trpc.llyb.backend.admin.CalendarDay
+	42, // 35: trpc.llyb.backend.admin.AlmanacDayResponse.day:type_name -> trpc.llyb.backend.admin.CalendarDay
+	6,  // 36: trpc.llyb.backend.admin.DateSelectionRequest.participants:type_name -> trpc.llyb.backend.admin.ReasoningRequest
+	47, // 37: trpc.llyb.backend.admin.DateCandidate.reasons:type_name -> trpc.llyb.backend.admin.DateReason
+	48, // 38: trpc.llyb.backend.admin.DateSelectionResponse.days:type_name -> trpc.llyb.backend.admin.DateCandidate
+	6,  // 39: trpc.llyb.backend.admin.ZiweiChartRequest.birth:type_name -> trpc.llyb.backend.admin.ReasoningRequest
+	51, // 40: trpc.llyb.backend.admin.ZiweiPalace.stars:type_name -> trpc.llyb.backend.admin.ZiweiStar
+	9,  // 41: trpc.llyb.backend.admin.ZiweiPlate.lunar_date:type_name -> trpc.llyb.backend.admin.LunarDateInfo
+	52, // 42: trpc.llyb.backend.admin.ZiweiPlate.palaces:type_name -> trpc.llyb.backend.admin.ZiweiPalace
+	53, // 43: trpc.llyb.backend.admin.ZiweiCandidate.chart:type_name -> trpc.llyb.backend.admin.ZiweiPlate
+	11, // 44: trpc.llyb.backend.admin.ZiweiChartResponse.solar_time:type_name -> trpc.llyb.backend.admin.SolarTimeInfo
+	53, // 45: trpc.llyb.backend.admin.ZiweiChartResponse.chart:type_name -> trpc.llyb.backend.admin.ZiweiPlate
+	54, // 46: trpc.llyb.backend.admin.ZiweiChartResponse.candidates:type_name -> trpc.llyb.backend.admin.ZiweiCandidate
+	2,  // 47: trpc.llyb.backend.admin.Admin.Login:input_type -> trpc.llyb.backend.admin.LoginRequest
+	4,  // 48: trpc.llyb.backend.admin.Admin.Register:input_type -> trpc.llyb.backend.admin.RegisterRequest
+	6,  // 49: trpc.llyb.backend.admin.Admin.Reasoning:input_type -> trpc.llyb.backend.admin.ReasoningRequest
+	20, // 50: trpc.llyb.backend.admin.Admin.SolarTerms:input_type -> trpc.llyb.backend.admin.SolarTermsRequest
+	23, // 51: trpc.llyb.backend.admin.Admin.GeoCacheList:input_type -> trpc.llyb.backend.admin.GeoCacheListRequest
+	26, // 52: trpc.llyb.backend.admin.Admin.GeoCachePurge:input_type -> trpc.llyb.backend.admin.GeoCachePurgeRequest
+	28, // 53: trpc.llyb.backend.admin.Admin.Timeline:input_type -> trpc.llyb.backend.admin.TimelineRequest
+	33, // 54: trpc.llyb.backend.admin.Admin.Compatibility:input_type -> trpc.llyb.backend.admin.CompatibilityRequest
+	38, // 55: trpc.llyb.backend.admin.Admin.ReverseLookup:input_type -> trpc.llyb.backend.admin.ReverseLookupRequest
+	41, // 56: trpc.llyb.backend.admin.Admin.Calendar:input_type -> trpc.llyb.backend.admin.CalendarRequest
+	44, // 57: trpc.llyb.backend.admin.Admin.AlmanacDay:input_type -> trpc.llyb.backend.admin.AlmanacDayRequest
+	46, // 58: trpc.llyb.backend.admin.Admin.DateSelection:input_type -> trpc.llyb.backend.admin.DateSelectionRequest
+	50, // 59: trpc.llyb.backend.admin.Admin.ZiweiChart:input_type -> trpc.llyb.backend.admin.ZiweiChartRequest
+	3,  // 60: trpc.llyb.backend.admin.Admin.Login:output_type -> trpc.llyb.backend.admin.LoginResponse
+	5,  // 61: trpc.llyb.backend.admin.Admin.Register:output_type -> trpc.llyb.backend.admin.RegisterResponse
+	7,  // 62: trpc.llyb.backend.admin.Admin.Reasoning:output_type -> trpc.llyb.backend.admin.ReasoningResponse
+	22, // 63: trpc.llyb.backend.admin.Admin.SolarTerms:output_type -> trpc.llyb.backend.admin.SolarTermsResponse
+	25, // 64: trpc.llyb.backend.admin.Admin.GeoCacheList:output_type -> trpc.llyb.backend.admin.GeoCacheListResponse
+	27, // 65: trpc.llyb.backend.admin.Admin.GeoCachePurge:output_type -> trpc.llyb.backend.admin.GeoCachePurgeResponse
+	32, // 66: trpc.llyb.backend.admin.Admin.Timeline:output_type -> trpc.llyb.backend.admin.TimelineResponse
+	37, // 67: trpc.llyb.backend.admin.Admin.Compatibility:output_type -> trpc.llyb.backend.admin.CompatibilityResponse
+	40, // 68: trpc.llyb.backend.admin.Admin.ReverseLookup:output_type -> trpc.llyb.backend.admin.ReverseLookupResponse
+	43, // 69: trpc.llyb.backend.admin.Admin.Calendar:output_type -> trpc.llyb.backend.admin.CalendarResponse
+	45, // 70: trpc.llyb.backend.admin.Admin.AlmanacDay:output_type -> trpc.llyb.backend.admin.AlmanacDayResponse
+	49, // 71: trpc.llyb.backend.admin.Admin.DateSelection:output_type -> trpc.llyb.backend.admin.DateSelectionResponse
+	55, // 72: trpc.llyb.backend.admin.Admin.ZiweiChart:output_type -> trpc.llyb.backend.admin.ZiweiChartResponse
+	60, // [60:73] is the sub-list for method output_type
+	47, // [47:60] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_admin_proto_init() }
@@ -517,13 +5580,16 @@ func file_admin_proto_init() {
 	if File_admin_proto != nil {
 		return
 	}
+	file_admin_proto_msgTypes[4].OneofWrappers = []any{}
+	file_admin_proto_msgTypes[9].OneofWrappers = []any{}
+	file_admin_proto_msgTypes[36].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_proto_rawDesc), len(file_admin_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   55,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,26 @@ type AdminService interface {
 	Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error)
 	// Reasoning Basic "reasoning" endpoint used by the front-end "基础推理" page.
 	Reasoning(ctx context.Context, req *ReasoningRequest) (*ReasoningResponse, error)
+	// SolarTerms The 24 solar terms (节气) of a Gregorian year.
+	SolarTerms(ctx context.Context, req *SolarTermsRequest) (*SolarTermsResponse, error)
+	// GeoCacheList List geocode cache entries (memory + MySQL tiers).
+	GeoCacheList(ctx context.Context, req *GeoCacheListRequest) (*GeoCacheListResponse, error)
+	// GeoCachePurge Purge geocode cache entries by key, expired ones, or all.
+	GeoCachePurge(ctx context.Context, req *GeoCachePurgeRequest) (*GeoCachePurgeResponse, error)
+	// Timeline Luck pillars (大运) with their annual pillars (流年), paginated by decade.
+	Timeline(ctx context.Context, req *TimelineRequest) (*TimelineResponse, error)
+	// Compatibility Two-person compatibility (合婚): both charts and a scored analysis.
+	Compatibility(ctx context.Context, req *CompatibilityRequest) (*CompatibilityResponse, error)
+	// ReverseLookup Reverse lookup (反推): birth datetime windows whose chart has the given pillars.
+	ReverseLookup(ctx context.Context, req *ReverseLookupRequest) (*ReverseLookupResponse, error)
+	// Calendar 万年历 month grid with lunar dates, pillars and solar terms; cacheable by ETag.
+	Calendar(ctx context.Context, req *CalendarRequest) (*CalendarResponse, error)
+	// AlmanacDay Daily almanac (黄历): 建除十二神, 值日星宿, 彭祖百忌, 冲煞 and 宜/忌.
+	AlmanacDay(ctx context.Context, req *AlmanacDayRequest) (*AlmanacDayResponse, error)
+	// DateSelection Date selection (择日): rank the days of a range for an activity and participants.
+	DateSelection(ctx context.Context, req *DateSelectionRequest) (*DateSelectionResponse, error)
+	// ZiweiChart 紫微斗数 chart: 命宫/身宫, the twelve palaces with their stars, 四化 and 大限.
+	ZiweiChart(ctx context.Context, req *ZiweiChartRequest) (*ZiweiChartResponse, error)
 }
 
 func AdminService_Login_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
@@ -80,6 +100,186 @@ func AdminService_Reasoning_Handler(svr interface{}, ctx context.Context, f serv
 	return rsp, nil
 }
 
+func AdminService_SolarTerms_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &SolarTermsRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).SolarTerms(ctx, reqbody.(*SolarTermsRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_GeoCacheList_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &GeoCacheListRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).GeoCacheList(ctx, reqbody.(*GeoCacheListRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_GeoCachePurge_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &GeoCachePurgeRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).GeoCachePurge(ctx, reqbody.(*GeoCachePurgeRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_Timeline_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &TimelineRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).Timeline(ctx, reqbody.(*TimelineRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_Compatibility_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &CompatibilityRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).Compatibility(ctx, reqbody.(*CompatibilityRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_ReverseLookup_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &ReverseLookupRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).ReverseLookup(ctx, reqbody.(*ReverseLookupRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_Calendar_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &CalendarRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).Calendar(ctx, reqbody.(*CalendarRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_AlmanacDay_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &AlmanacDayRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).AlmanacDay(ctx, reqbody.(*AlmanacDayRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_DateSelection_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &DateSelectionRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).DateSelection(ctx, reqbody.(*DateSelectionRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func AdminService_ZiweiChart_Handler(svr interface{}, ctx context.Context, f server.FilterFunc) (interface{}, error) {
+	req := &ZiweiChartRequest{}
+	filters, err := f(req)
+	if err != nil {
+		return nil, err
+	}
+	handleFunc := func(ctx context.Context, reqbody interface{}) (interface{}, error) {
+		return svr.(AdminService).ZiweiChart(ctx, reqbody.(*ZiweiChartRequest))
+	}
+
+	var rsp interface{}
+	rsp, err = filters.Filter(ctx, req, handleFunc)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
 // AdminServer_ServiceDesc descriptor for server.RegisterService.
 var AdminServer_ServiceDesc = server.ServiceDesc{
 	ServiceName: "trpc.llyb.backend.admin.Admin",
@@ -97,6 +297,46 @@ var AdminServer_ServiceDesc = server.ServiceDesc{
 			Name: "/admin/reasoning",
 			Func: AdminService_Reasoning_Handler,
 		},
+		{
+			Name: "/bazi/solar-terms",
+			Func: AdminService_SolarTerms_Handler,
+		},
+		{
+			Name: "/admin/geo-cache/list",
+			Func: AdminService_GeoCacheList_Handler,
+		},
+		{
+			Name: "/admin/geo-cache/purge",
+			Func: AdminService_GeoCachePurge_Handler,
+		},
+		{
+			Name: "/bazi/timeline",
+			Func: AdminService_Timeline_Handler,
+		},
+		{
+			Name: "/bazi/compatibility",
+			Func: AdminService_Compatibility_Handler,
+		},
+		{
+			Name: "/bazi/reverse-lookup",
+			Func: AdminService_ReverseLookup_Handler,
+		},
+		{
+			Name: "/bazi/calendar",
+			Func: AdminService_Calendar_Handler,
+		},
+		{
+			Name: "/almanac/day",
+			Func: AdminService_AlmanacDay_Handler,
+		},
+		{
+			Name: "/almanac/date-selection",
+			Func: AdminService_DateSelection_Handler,
+		},
+		{
+			Name: "/ziwei/chart",
+			Func: AdminService_ZiweiChart_Handler,
+		},
 		{
 			Name: "/trpc.llyb.backend.admin.Admin/Login",
 			Func: AdminService_Login_Handler,
@@ -109,6 +349,46 @@ var AdminServer_ServiceDesc = server.ServiceDesc{
 			Name: "/trpc.llyb.backend.admin.Admin/Reasoning",
 			Func: AdminService_Reasoning_Handler,
 		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/SolarTerms",
+			Func: AdminService_SolarTerms_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/GeoCacheList",
+			Func: AdminService_GeoCacheList_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/GeoCachePurge",
+			Func: AdminService_GeoCachePurge_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/Timeline",
+			Func: AdminService_Timeline_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/Compatibility",
+			Func: AdminService_Compatibility_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/ReverseLookup",
+			Func: AdminService_ReverseLookup_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/Calendar",
+			Func: AdminService_Calendar_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/AlmanacDay",
+			Func: AdminService_AlmanacDay_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/DateSelection",
+			Func: AdminService_DateSelection_Handler,
+		},
+		{
+			Name: "/trpc.llyb.backend.admin.Admin/ZiweiChart",
+			Func: AdminService_ZiweiChart_Handler,
+		},
 	},
 }
 
@@ -137,6 +417,56 @@ func (s *UnimplementedAdmin) Reasoning(ctx context.Context, req *ReasoningReques
 	return nil, errors.New("rpc Reasoning of service Admin is not implemented")
 }
 
+// SolarTerms The 24 solar terms (节气) of a Gregorian year.
+func (s *UnimplementedAdmin) SolarTerms(ctx context.Context, req *SolarTermsRequest) (*SolarTermsResponse, error) {
+	return nil, errors.New("rpc SolarTerms of service Admin is not implemented")
+}
+
+// GeoCacheList List geocode cache entries (memory + MySQL tiers).
+func (s *UnimplementedAdmin) GeoCacheList(ctx context.Context, req *GeoCacheListRequest) (*GeoCacheListResponse, error) {
+	return nil, errors.New("rpc GeoCacheList of service Admin is not implemented")
+}
+
+// GeoCachePurge Purge geocode cache entries by key, expired ones, or all.
+func (s *UnimplementedAdmin) GeoCachePurge(ctx context.Context, req *GeoCachePurgeRequest) (*GeoCachePurgeResponse, error) {
+	return nil, errors.New("rpc GeoCachePurge of service Admin is not implemented")
+}
+
+// Timeline Luck pillars (大运) with their annual pillars (流年), paginated by decade.
+func (s *UnimplementedAdmin) Timeline(ctx context.Context, req *TimelineRequest) (*TimelineResponse, error) {
+	return nil, errors.New("rpc Timeline of service Admin is not implemented")
+}
+
+// Compatibility Two-person compatibility (合婚): both charts and a scored analysis.
+func (s *UnimplementedAdmin) Compatibility(ctx context.Context, req *CompatibilityRequest) (*CompatibilityResponse, error) {
+	return nil, errors.New("rpc Compatibility of service Admin is not implemented")
+}
+
+// ReverseLookup Reverse lookup (反推): birth datetime windows whose chart has the given pillars.
+func (s *UnimplementedAdmin) ReverseLookup(ctx context.Context, req *ReverseLookupRequest) (*ReverseLookupResponse, error) {
+	return nil, errors.New("rpc ReverseLookup of service Admin is not implemented")
+}
+
+// Calendar 万年历 month grid with lunar dates, pillars and solar terms; cacheable by ETag.
+func (s *UnimplementedAdmin) Calendar(ctx context.Context, req *CalendarRequest) (*CalendarResponse, error) {
+	return nil, errors.New("rpc Calendar of service Admin is not implemented")
+}
+
+// AlmanacDay Daily almanac (黄历): 建除十二神, 值日星宿, 彭祖百忌, 冲煞 and 宜/忌.
+func (s *UnimplementedAdmin) AlmanacDay(ctx context.Context, req *AlmanacDayRequest) (*AlmanacDayResponse, error) {
+	return nil, errors.New("rpc AlmanacDay of service Admin is not implemented")
+}
+
+// DateSelection Date selection (择日): rank the days of a range for an activity and participants.
+func (s *UnimplementedAdmin) DateSelection(ctx context.Context, req *DateSelectionRequest) (*DateSelectionResponse, error) {
+	return nil, errors.New("rpc DateSelection of service Admin is not implemented")
+}
+
+// ZiweiChart 紫微斗数 chart: 命宫/身宫, the twelve palaces with their stars, 四化 and 大限.
+func (s *UnimplementedAdmin) ZiweiChart(ctx context.Context, req *ZiweiChartRequest) (*ZiweiChartResponse, error) {
+	return nil, errors.New("rpc ZiweiChart of service Admin is not implemented")
+}
+
 // END --------------------------------- Default Unimplemented Server Service --------------------------------- END
 
 // END ======================================= Server Service Definition ======================================= END
@@ -150,6 +480,26 @@ type AdminClientProxy interface {
 	Register(ctx context.Context, req *RegisterRequest, opts ...client.Option) (rsp *RegisterResponse, err error)
 	// Reasoning Basic "reasoning" endpoint used by the front-end "基础推理" page.
 	Reasoning(ctx context.Context, req *ReasoningRequest, opts ...client.Option) (rsp *ReasoningResponse, err error)
+	// SolarTerms The 24 solar terms (节气) of a Gregorian year.
+	SolarTerms(ctx context.Context, req *SolarTermsRequest, opts ...client.Option) (rsp *SolarTermsResponse, err error)
+	// GeoCacheList List geocode cache entries (memory + MySQL tiers).
+	GeoCacheList(ctx context.Context, req *GeoCacheListRequest, opts ...client.Option) (rsp *GeoCacheListResponse, err error)
+	// GeoCachePurge Purge geocode cache entries by key, expired ones, or all.
+	GeoCachePurge(ctx context.Context, req *GeoCachePurgeRequest, opts ...client.Option) (rsp *GeoCachePurgeResponse, err error)
+	// Timeline Luck pillars (大运) with their annual pillars (流年), paginated by decade.
+	Timeline(ctx context.Context, req *TimelineRequest, opts ...client.Option) (rsp *TimelineResponse, err error)
+	// Compatibility Two-person compatibility (合婚): both charts and a scored analysis.
+	Compatibility(ctx context.Context, req *CompatibilityRequest, opts ...client.Option) (rsp *CompatibilityResponse, err error)
+	// ReverseLookup Reverse lookup (反推): birth datetime windows whose chart has the given pillars.
+	ReverseLookup(ctx context.Context, req *ReverseLookupRequest, opts ...client.Option) (rsp *ReverseLookupResponse, err error)
+	// Calendar 万年历 month grid with lunar dates, pillars and solar terms; cacheable by ETag.
+	Calendar(ctx context.Context, req *CalendarRequest, opts ...client.Option) (rsp *CalendarResponse, err error)
+	// AlmanacDay Daily almanac (黄历): 建除十二神, 值日星宿, 彭祖百忌, 冲煞 and 宜/忌.
+	AlmanacDay(ctx context.Context, req *AlmanacDayRequest, opts ...client.Option) (rsp *AlmanacDayResponse, err error)
+	// DateSelection Date selection (择日): rank the days of a range for an activity and participants.
+	DateSelection(ctx context.Context, req *DateSelectionRequest, opts ...client.Option) (rsp *DateSelectionResponse, err error)
+	// ZiweiChart 紫微斗数 chart: 命宫/身宫, the twelve palaces with their stars, 四化 and 大限.
+	ZiweiChart(ctx context.Context, req *ZiweiChartRequest, opts ...client.Option) (rsp *ZiweiChartResponse, err error)
 }
 
 type AdminClientProxyImpl struct {
@@ -221,4 +571,204 @@ func (c *AdminClientProxyImpl) Reasoning(ctx context.Context, req *ReasoningRequ
 	return rsp, nil
 }
 
+func (c *AdminClientProxyImpl) SolarTerms(ctx context.Context, req *SolarTermsRequest, opts ...client.Option) (*SolarTermsResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/bazi/solar-terms")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("SolarTerms")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &SolarTermsResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) GeoCacheList(ctx context.Context, req *GeoCacheListRequest, opts ...client.Option) (*GeoCacheListResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/admin/geo-cache/list")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("GeoCacheList")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &GeoCacheListResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) GeoCachePurge(ctx context.Context, req *GeoCachePurgeRequest, opts ...client.Option) (*GeoCachePurgeResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/admin/geo-cache/purge")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("GeoCachePurge")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &GeoCachePurgeResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) Timeline(ctx context.Context, req *TimelineRequest, opts ...client.Option) (*TimelineResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/bazi/timeline")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("Timeline")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &TimelineResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) Compatibility(ctx context.Context, req *CompatibilityRequest, opts ...client.Option) (*CompatibilityResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/bazi/compatibility")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("Compatibility")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &CompatibilityResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) ReverseLookup(ctx context.Context, req *ReverseLookupRequest, opts ...client.Option) (*ReverseLookupResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/bazi/reverse-lookup")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("ReverseLookup")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &ReverseLookupResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) Calendar(ctx context.Context, req *CalendarRequest, opts ...client.Option) (*CalendarResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/bazi/calendar")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("Calendar")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &CalendarResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) AlmanacDay(ctx context.Context, req *AlmanacDayRequest, opts ...client.Option) (*AlmanacDayResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/almanac/day")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("AlmanacDay")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &AlmanacDayResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) DateSelection(ctx context.Context, req *DateSelectionRequest, opts ...client.Option) (*DateSelectionResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/almanac/date-selection")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("DateSelection")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &DateSelectionResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func (c *AdminClientProxyImpl) ZiweiChart(ctx context.Context, req *ZiweiChartRequest, opts ...client.Option) (*ZiweiChartResponse, error) {
+	ctx, msg := codec.WithCloneMessage(ctx)
+	defer codec.PutBackMessage(msg)
+	msg.WithClientRPCName("/ziwei/chart")
+	msg.WithCalleeServiceName(AdminServer_ServiceDesc.ServiceName)
+	msg.WithCalleeApp("")
+	msg.WithCalleeServer("")
+	msg.WithCalleeService("Admin")
+	msg.WithCalleeMethod("ZiweiChart")
+	msg.WithSerializationType(codec.SerializationTypePB)
+	callopts := make([]client.Option, 0, len(c.opts)+len(opts))
+	callopts = append(callopts, c.opts...)
+	callopts = append(callopts, opts...)
+	rsp := &ZiweiChartResponse{}
+	if err := c.client.Invoke(ctx, req, rsp, callopts...); err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
 // END ======================================= Client Service Definition ======================================= END
@@ -8,6 +8,7 @@ import (
 	"llyb-backend/bazi"
 	"llyb-backend/login"
 	pb "llyb-backend/proto"
+	"llyb-backend/ziwei"
 )
 
 // AdminService keeps all interface handlers in one file for now.
@@ -47,3 +48,100 @@ func (s *AdminService) Reasoning(ctx context.Context, req *pb.ReasoningRequest)
 	}
 	return resp, nil
 }
+
+func (s *AdminService) SolarTerms(ctx context.Context, req *pb.SolarTermsRequest) (*pb.SolarTermsResponse, error) {
+	resp, err := bazi.SolarTerms(ctx, req)
+	if err != nil {
+		log.Printf("solar terms failed: year=%d err=%v", req.GetYear(), err)
+		return &pb.SolarTermsResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) GeoCacheList(ctx context.Context, req *pb.GeoCacheListRequest) (*pb.GeoCacheListResponse, error) {
+	resp, err := bazi.GeoCacheList(ctx, req)
+	if err != nil {
+		log.Printf("geo cache list failed: keyword=%q err=%v", req.GetKeyword(), err)
+		return &pb.GeoCacheListResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) GeoCachePurge(ctx context.Context, req *pb.GeoCachePurgeRequest) (*pb.GeoCachePurgeResponse, error) {
+	resp, err := bazi.GeoCachePurge(ctx, req)
+	if err != nil {
+		log.Printf("geo cache purge failed: key=%q err=%v", req.GetKey(), err)
+		return &pb.GeoCachePurgeResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) Timeline(ctx context.Context, req *pb.TimelineRequest) (*pb.TimelineResponse, error) {
+	resp, err := bazi.Timeline(ctx, req)
+	if err != nil {
+		log.Printf("timeline failed: err=%v", err)
+		return &pb.TimelineResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) Compatibility(ctx context.Context, req *pb.CompatibilityRequest) (*pb.CompatibilityResponse, error) {
+	resp, err := bazi.Compatibility(ctx, req)
+	if err != nil {
+		log.Printf("compatibility failed: err=%v", err)
+		return &pb.CompatibilityResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) ReverseLookup(ctx context.Context, req *pb.ReverseLookupRequest) (*pb.ReverseLookupResponse, error) {
+	resp, err := bazi.ReverseLookup(ctx, req)
+	if err != nil {
+		log.Printf("reverse lookup failed: err=%v", err)
+		return &pb.ReverseLookupResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) Calendar(ctx context.Context, req *pb.CalendarRequest) (*pb.CalendarResponse, error) {
+	resp, err := bazi.Calendar(ctx, req)
+	if err != nil {
+		log.Printf("calendar failed: year=%d month=%d err=%v", req.GetYear(), req.GetMonth(), err)
+		return &pb.CalendarResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	if resp.GetCode() == 0 {
+		setCacheHeaders(ctx, resp.GetEtag(), calendarMaxAge)
+	}
+	return resp, nil
+}
+
+func (s *AdminService) AlmanacDay(ctx context.Context, req *pb.AlmanacDayRequest) (*pb.AlmanacDayResponse, error) {
+	resp, err := bazi.AlmanacDay(ctx, req)
+	if err != nil {
+		log.Printf("almanac failed: date=%q err=%v", req.GetDate(), err)
+		return &pb.AlmanacDayResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	// Without a date the answer is "today", which changes at midnight.
+	if resp.GetCode() == 0 && req.GetDate() != "" {
+		setCacheHeaders(ctx, resp.GetEtag(), calendarMaxAge)
+	}
+	return resp, nil
+}
+
+func (s *AdminService) DateSelection(ctx context.Context, req *pb.DateSelectionRequest) (*pb.DateSelectionResponse, error) {
+	resp, err := bazi.DateSelection(ctx, req)
+	if err != nil {
+		log.Printf("date selection failed: activity=%q err=%v", req.GetActivity(), err)
+		return &pb.DateSelectionResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
+
+func (s *AdminService) ZiweiChart(ctx context.Context, req *pb.ZiweiChartRequest) (*pb.ZiweiChartResponse, error) {
+	resp, err := ziwei.Chart(ctx, req)
+	if err != nil {
+		log.Printf("ziwei chart failed: err=%v", err)
+		return &pb.ZiweiChartResponse{Code: 1003, Message: "系统错误"}, nil
+	}
+	return resp, nil
+}
@@ -0,0 +1,11 @@
+-- Geocode cache (second tier behind the in-memory LRU) for bazi.ResolveCityLongitude
+CREATE TABLE IF NOT EXISTS geo_cache (
+  cache_key VARCHAR(191) NOT NULL,
+  longitude DOUBLE NOT NULL,
+  latitude DOUBLE NOT NULL,
+  provider VARCHAR(32) NOT NULL,
+  created_at DATETIME NOT NULL,
+  expires_at DATETIME NOT NULL,
+  PRIMARY KEY (cache_key),
+  KEY idx_expires_at (expires_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
@@ -0,0 +1,108 @@
+package ziwei
+
+import (
+	"context"
+
+	"llyb-backend/bazi"
+	pb "llyb-backend/proto"
+)
+
+// Chart is the backend handler for "/ziwei/chart": the 紫微斗数 chart of a birth,
+// resolved (lunar date, true solar time) as for /admin/reasoning.
+func Chart(ctx context.Context, req *pb.ZiweiChartRequest) (*pb.ZiweiChartResponse, error) {
+	var male bool
+	switch req.GetBirth().GetGender() {
+	case pb.Gender_GENDER_MALE:
+		male = true
+	case pb.Gender_GENDER_FEMALE:
+	default:
+		return &pb.ZiweiChartResponse{
+			Code:    1002,
+			Message: "请选择性别：大限顺逆由性别与年干阴阳决定",
+		}, nil
+	}
+
+	b, err := bazi.ResolveBirth(ctx, req.GetBirth())
+	if err != nil {
+		return &pb.ZiweiChartResponse{
+			Code:    1002,
+			Message: err.Error(),
+		}, nil
+	}
+	if len(b.Hours) == 0 {
+		return &pb.ZiweiChartResponse{
+			Code:    1002,
+			Message: "出生日期或时间格式不正确",
+		}, nil
+	}
+
+	resp := &pb.ZiweiChartResponse{
+		Code:        0,
+		Message:     "ok",
+		SolarTime:   b.SolarTime,
+		Approximate: b.Approximate,
+	}
+	// Nearly the whole chart hangs on the 时辰: a range covering several gets one
+	// chart per 时辰 rather than a chart of its middle.
+	for _, lh := range b.Hours {
+		p, err := NewPlate(lh.Lunar, lh.Hour, male)
+		if err != nil {
+			return nil, err
+		}
+		if len(b.Hours) == 1 {
+			resp.Chart = plateInfo(p)
+			break
+		}
+		resp.Candidates = append(resp.Candidates, &pb.ZiweiCandidate{
+			TimeFrom: lh.From,
+			TimeTo:   lh.To,
+			Chart:    plateInfo(p),
+		})
+	}
+	return resp, nil
+}
+
+func plateInfo(p Plate) *pb.ZiweiPlate {
+	direction := "backward"
+	if p.Forward {
+		direction = "forward"
+	}
+	info := &pb.ZiweiPlate{
+		LunarDate: &pb.LunarDateInfo{
+			Year:        int32(p.Lunar.Year),
+			Month:       int32(p.Lunar.Month),
+			Day:         int32(p.Lunar.Day),
+			IsLeapMonth: p.Lunar.IsLeapMonth,
+			Text:        p.Lunar.String(),
+		},
+		YearPillar: p.Year.String(),
+		Hour:       p.Hour.String(),
+		LifeBranch: p.Life.String(),
+		BodyBranch: p.Body.String(),
+		BodyPalace: p.CellAt(p.Body).Palace.String(),
+		Bureau:     p.Bureau.String(),
+		LifeMaster: p.LifeMaster,
+		BodyMaster: p.BodyMaster,
+		Direction:  direction,
+	}
+	for _, c := range p.Cells {
+		palace := &pb.ZiweiPalace{
+			Name:       c.Palace.String(),
+			Branch:     c.Branch.String(),
+			Stem:       c.Stem.String(),
+			Body:       c.Body,
+			DecadeFrom: int32(c.DecadeFrom),
+			DecadeTo:   int32(c.DecadeTo),
+		}
+		for _, s := range c.Stars {
+			palace.Stars = append(palace.Stars, &pb.ZiweiStar{
+				Name:       s.Name,
+				Major:      s.Major,
+				Brightness: s.Brightness,
+				Transform:  s.Transform,
+			})
+		}
+		info.Palaces = append(info.Palaces, palace)
+	}
+	return info
+}
@@ -0,0 +1,58 @@
+package ziwei
+
+import (
+	"context"
+	"testing"
+
+	pb "llyb-backend/proto"
+)
+
+func hangzhouBirth(from, to string) *pb.ReasoningRequest {
+	lon, lat := 120.16, 30.27
+	return &pb.ReasoningRequest{
+		Gender:        pb.Gender_GENDER_FEMALE,
+		SolarDate:     "2000-08-16",
+		Province:      "浙江省",
+		City:          "杭州市",
+		Longitude:     &lon,
+		Latitude:      &lat,
+		BirthTimeFrom: from,
+		BirthTimeTo:   to,
+	}
+}
+
+// A time range spanning several 时辰 gets one chart per 时辰, split at the clock times
+// the true solar time crosses a 时辰 boundary.
+func TestChartCandidatesPerHour(t *testing.T) {
+	resp, err := Chart(context.Background(), &pb.ZiweiChartRequest{Birth: hangzhouBirth("22:00", "23:50")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Code != 0 || resp.Chart != nil {
+		t.Fatalf("code %d %q, chart %v; want candidates only", resp.Code, resp.Message, resp.Chart)
+	}
+	want := []struct{ from, to, hour string }{
+		{"22:00", "23:03", "亥"},
+		{"23:04", "23:50", "子"},
+	}
+	if len(resp.Candidates) != len(want) {
+		t.Fatalf("got %d candidates, want %d", len(resp.Candidates), len(want))
+	}
+	for i, w := range want {
+		c := resp.Candidates[i]
+		if c.TimeFrom != w.from || c.TimeTo != w.to || c.Chart.GetHour() != w.hour {
+			t.Errorf("candidate %d = %s-%s %s, want %s-%s %s", i, c.TimeFrom, c.TimeTo, c.Chart.GetHour(), w.from, w.to, w.hour)
+		}
+	}
+}
+
+func TestChartSingleHour(t *testing.T) {
+	resp, err := Chart(context.Background(), &pb.ZiweiChartRequest{Birth: hangzhouBirth("03:20", "04:50")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Code != 0 || len(resp.Candidates) != 0 || resp.Chart.GetHour() != "寅" || resp.Chart.GetLifeBranch() != "午" {
+		t.Errorf("code %d %q, %d candidates, hour %s 命宫 %s; want a single 寅 chart with 命宫 午",
+			resp.Code, resp.Message, len(resp.Candidates), resp.Chart.GetHour(), resp.Chart.GetLifeBranch())
+	}
+}
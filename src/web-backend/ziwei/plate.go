@@ -0,0 +1,135 @@
+// Package ziwei builds 紫微斗数 charts (命盘) from a lunar birth date and 时辰, as
+// resolved by package bazi.
+package ziwei
+
+import (
+	"llyb-backend/bazi"
+)
+
+// Palace is one of the twelve palaces (十二宫), 0=命宫 ... 11=父母. They are laid out
+// counter-clockwise from 命宫 on the fixed ring of branches.
+type Palace int
+
+var palaceNames = [12]string{"命宫", "兄弟", "夫妻", "子女", "财帛", "疾厄", "迁移", "交友", "官禄", "田宅", "福德", "父母"}
+
+func (p Palace) String() string { return palaceNames[mod(int(p), 12)] }
+
+// Bureau is the 五行局 of a chart: 2 水二局, 3 木三局, 4 金四局, 5 土五局, 6 火六局.
+// It places 紫微 and is the age the first 大限 starts at.
+type Bureau int
+
+var bureauNames = map[Bureau]string{2: "水二局", 3: "木三局", 4: "金四局", 5: "土五局", 6: "火六局"}
+
+func (b Bureau) String() string { return bureauNames[b] }
+
+// bureauOfElement maps the element of the 命宫's 纳音 to its bureau.
+var bureauOfElement = map[string]Bureau{"水": 2, "木": 3, "金": 4, "土": 5, "火": 6}
+
+// Cell is one palace of a chart with the stars it holds.
+type Cell struct {
+	Palace Palace
+	Branch bazi.Branch
+	Stem   bazi.Stem // by 五虎遁 from the year stem
+	Body   bool      // 身宫 falls here
+	Stars  []Star
+	// 大限: the ten years (虚岁, inclusive) this palace governs.
+	DecadeFrom, DecadeTo int
+}
+
+// Plate is a 紫微斗数 chart.
+type Plate struct {
+	Lunar bazi.LunarDate
+	// Month is the month the chart is cast with: a leap month counts as its own month
+	// up to day 15 and as the next month after.
+	Month  int
+	Year   bazi.Pillar // 干支 of the lunar year (it changes at 正月初一, not 立春)
+	Hour   bazi.Branch
+	Life   bazi.Branch // 命宫
+	Body   bazi.Branch // 身宫
+	Bureau Bureau
+	// 命主 by the 命宫 branch, 身主 by the year branch.
+	LifeMaster, BodyMaster string
+	// Forward: 大限 run clockwise (阳男阴女); otherwise counter-clockwise.
+	Forward bool
+	// Cells by palace, 命宫 first.
+	Cells [12]Cell
+}
+
+// CellAt returns the cell on branch b.
+func (p *Plate) CellAt(b bazi.Branch) *Cell {
+	for i := range p.Cells {
+		if p.Cells[i].Branch == b {
+			return &p.Cells[i]
+		}
+	}
+	return nil
+}
+
+var (
+	lifeMasters = [12]string{"贪狼", "巨门", "禄存", "文曲", "廉贞", "武曲", "破军", "武曲", "廉贞", "文曲", "禄存", "巨门"}
+	bodyMasters = [12]string{"火星", "天相", "天梁", "天同", "文昌", "天机", "火星", "天相", "天梁", "天同", "文昌", "天机"}
+)
+
+// NewPlate casts the chart of a birth on lunar date ld in hour branch hour.
+func NewPlate(ld bazi.LunarDate, hour bazi.Branch, male bool) (Plate, error) {
+	month := ld.Month
+	if ld.IsLeapMonth && ld.Day > 15 {
+		month = month%12 + 1
+	}
+	p := Plate{
+		Lunar: ld,
+		Month: month,
+		Year:  bazi.PillarFromIndex(ld.Year - 4),
+		Hour:  hour,
+	}
+
+	// 命宫: from 寅 count the month forward, then the hour backward; 身宫 counts the
+	// hour forward instead.
+	h := int(hour)
+	p.Life = bazi.Branch(mod(2+month-1-h, 12))
+	p.Body = bazi.Branch(mod(2+month-1+h, 12))
+	p.LifeMaster, p.BodyMaster = lifeMasters[p.Life], bodyMasters[p.Year.Branch]
+	p.Forward = male == p.Year.Stem.IsYang()
+
+	for i := range p.Cells {
+		br := bazi.Branch(mod(int(p.Life)-i, 12))
+		c := &p.Cells[i]
+		c.Palace, c.Branch, c.Stem = Palace(i), br, palaceStem(p.Year.Stem, br)
+		c.Body = br == p.Body
+	}
+
+	// 五行局 from the 纳音 of the 命宫's stem and branch.
+	nayin := []rune(bazi.Pillar{Stem: p.Cells[0].Stem, Branch: p.Life}.NaYin())
+	p.Bureau = bureauOfElement[string(nayin[len(nayin)-1])]
+
+	// 大限 start at 命宫 at the bureau's age and move one palace per decade.
+	for k := 0; k < 12; k++ {
+		step := k
+		if !p.Forward {
+			step = -k
+		}
+		c := p.CellAt(bazi.Branch(mod(int(p.Life)+step, 12)))
+		c.DecadeFrom = int(p.Bureau) + 10*k
+		c.DecadeTo = c.DecadeFrom + 9
+	}
+
+	if err := p.placeStars(); err != nil {
+		return Plate{}, err
+	}
+	return p, nil
+}
+
+// palaceStem is the stem of branch br in a year with stem ys: 寅 takes the 五虎遁 stem
+// and each branch after it the next one; 子 and 丑 continue after 亥.
+func palaceStem(ys bazi.Stem, br bazi.Branch) bazi.Stem {
+	return bazi.Stem(mod(int(ys)%5*2+2+mod(int(br)-2, 12), 10))
+}
+
+// mod is the always-non-negative remainder.
+func mod(a, n int) int {
+	r := a % n
+	if r < 0 {
+		r += n
+	}
+	return r
+}
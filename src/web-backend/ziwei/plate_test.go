@@ -0,0 +1,81 @@
+package ziwei
+
+import (
+	"strings"
+	"testing"
+
+	"llyb-backend/bazi"
+)
+
+// 紫微 by bureau for lunar days 1..30, as in the usual 紫微星诸局定位表.
+func TestZiweiBranch(t *testing.T) {
+	tests := []struct {
+		bureau Bureau
+		want   string
+	}{
+		{2, "丑寅寅卯卯辰辰巳巳午午未未申申酉酉戌戌亥亥子子丑丑寅寅卯卯辰"},
+		{3, "辰丑寅巳寅卯午卯辰未辰巳申巳午酉午未戌未申亥申酉子酉戌丑戌亥"},
+		{4, "亥辰丑寅子巳寅卯丑午卯辰寅未辰巳卯申巳午辰酉午未巳戌未申午亥"},
+		{5, "午亥辰丑寅未子巳寅卯申丑午卯辰酉寅未辰巳戌卯申巳午亥辰酉午未"},
+		{6, "酉午亥辰丑寅戌未子巳寅卯亥申丑午卯辰子酉寅未辰巳丑戌卯申巳午"},
+	}
+	for _, tt := range tests {
+		want := []rune(tt.want)
+		for day := 1; day <= 30; day++ {
+			if got := ziweiBranch(day, tt.bureau).String(); got != string(want[day-1]) {
+				t.Errorf("%s day %d: 紫微 in %s, want %c", tt.bureau, day, got, want[day-1])
+			}
+		}
+	}
+}
+
+// A woman born 2000-08-16 (农历七月十七) in the 寅 hour.
+func TestNewPlateReference(t *testing.T) {
+	p, err := NewPlate(bazi.LunarDate{Year: 2000, Month: 7, Day: 17}, 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := []string{p.Year.String(), p.Life.String(), p.Body.String(), p.Bureau.String(), p.LifeMaster, p.BodyMaster},
+		[]string{"庚辰", "午", "戌", "木三局", "破军", "文昌"}; strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("year, 命宫, 身宫, bureau, 命主, 身主 = %v, want %v", got, want)
+	}
+	if p.Forward {
+		t.Error("大限 run forward, want backward for a woman in a 阳 year")
+	}
+
+	tests := []struct {
+		palace, ganzhi string
+		decadeFrom     int
+		stars          string
+	}{
+		{"命宫", "壬午", 3, "紫微庙 文曲陷"},
+		{"兄弟", "辛巳", 13, "天机平"},
+		{"夫妻", "庚辰", 23, "七杀庙 右弼 火星陷"},
+		{"子女", "己卯", 33, "太阳庙化禄 天梁庙"},
+		{"财帛", "戊寅", 43, "武曲得化权 天相庙 天马"},
+		{"疾厄", "己丑", 53, "天同不化忌 巨门不 天魁 地劫"},
+		{"迁移", "戊子", 63, "贪狼旺 铃星陷"},
+		{"交友", "丁亥", 73, "太阴庙化科"},
+		{"官禄", "丙戌", 83, "廉贞利 天府庙 左辅"},
+		{"田宅", "乙酉", 93, "擎羊陷 地空"},
+		{"福德", "甲申", 103, "破军得 文昌得 禄存"},
+		{"父母", "癸未", 113, "天钺 陀罗庙"},
+	}
+	for i, tt := range tests {
+		c := p.Cells[i]
+		var stars []string
+		for _, s := range c.Stars {
+			stars = append(stars, s.Name+s.Brightness+s.Transform)
+		}
+		got := strings.Join(stars, " ")
+		if c.Palace.String() != tt.palace || c.Stem.String()+c.Branch.String() != tt.ganzhi ||
+			c.DecadeFrom != tt.decadeFrom || c.DecadeTo != tt.decadeFrom+9 || got != tt.stars {
+			t.Errorf("cell %d = %s %s%s %d-%d %q, want %s %s %d-%d %q", i,
+				c.Palace, c.Stem, c.Branch, c.DecadeFrom, c.DecadeTo, got,
+				tt.palace, tt.ganzhi, tt.decadeFrom, tt.decadeFrom+9, tt.stars)
+		}
+		if c.Body != (tt.palace == "官禄") {
+			t.Errorf("%s: body = %v", tt.palace, c.Body)
+		}
+	}
+}
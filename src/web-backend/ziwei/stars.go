@@ -0,0 +1,158 @@
+package ziwei
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"sync"
+
+	"llyb-backend/bazi"
+)
+
+// Star is a star placed in a palace.
+type Star struct {
+	Name  string
+	Major bool // one of the fourteen main stars (十四主星)
+	// Brightness is 庙 旺 得 利 平 不 or 陷; empty for stars without a brightness table.
+	Brightness string
+	// Transform is the 四化 by the year stem: 化禄 化权 化科 化忌, or empty.
+	Transform string
+}
+
+// The 紫微 group runs counter-clockwise from 紫微, the 天府 group clockwise from 天府.
+var (
+	ziweiGroup = []struct {
+		name   string
+		offset int
+	}{{"紫微", 0}, {"天机", -1}, {"太阳", -3}, {"武曲", -4}, {"天同", -5}, {"廉贞", -8}}
+	tianfuGroup = []struct {
+		name   string
+		offset int
+	}{{"天府", 0}, {"太阴", 1}, {"贪狼", 2}, {"巨门", 3}, {"天相", 4}, {"天梁", 5}, {"七杀", 6}, {"破军", 10}}
+)
+
+// ziweiBranch places 紫微: the day is padded up to a multiple of the bureau, the
+// quotient is counted from 寅, and the padding is then stepped back when odd or
+// forward when even.
+func ziweiBranch(day int, b Bureau) bazi.Branch {
+	n := int(b)
+	pad := mod(-day, n)
+	pos := 2 + (day+pad)/n - 1
+	if pad%2 == 1 {
+		pos -= pad
+	} else {
+		pos += pad
+	}
+	return bazi.Branch(mod(pos, 12))
+}
+
+// Year-stem stars, indexed by stem: 天魁, 天钺 and 禄存 (甲戊庚牛羊, 乙己鼠猴乡, ...).
+var (
+	kuiBranch   = [10]bazi.Branch{1, 0, 11, 11, 1, 0, 1, 6, 3, 3}
+	yueBranch   = [10]bazi.Branch{7, 8, 9, 9, 7, 8, 7, 2, 5, 5}
+	luCunBranch = [10]bazi.Branch{2, 3, 5, 6, 5, 6, 8, 9, 11, 0}
+)
+
+// Year-branch stars, indexed by the branch's triad (申子辰 0, 巳酉丑 1, 寅午戌 2, 亥卯未 3):
+// 天马, and where 火星 and 铃星 count the hour from.
+var (
+	tianMaBranch = [4]bazi.Branch{2, 11, 8, 5}
+	huoStart     = [4]bazi.Branch{2, 3, 1, 9}
+	lingStart    = [4]bazi.Branch{10, 10, 3, 10}
+)
+
+// transforms lists 化禄, 化权, 化科, 化忌 by year stem.
+var transforms = [10][4]string{
+	{"廉贞", "破军", "武曲", "太阳"},
+	{"天机", "天梁", "紫微", "太阴"},
+	{"天同", "天机", "文昌", "廉贞"},
+	{"太阴", "天同", "天机", "巨门"},
+	{"贪狼", "太阴", "右弼", "天机"},
+	{"武曲", "贪狼", "天梁", "文曲"},
+	{"太阳", "武曲", "太阴", "天同"},
+	{"巨门", "太阳", "文曲", "文昌"},
+	{"天梁", "紫微", "左辅", "武曲"},
+	{"破军", "巨门", "太阴", "贪狼"},
+}
+
+var transformNames = [4]string{"化禄", "化权", "化科", "化忌"}
+
+//go:embed data/brightness.csv
+var brightnessCSV string
+
+// loadBrightness reads the brightness table: star name → brightness by branch.
+var loadBrightness = sync.OnceValues(func() (map[string][12]string, error) {
+	r := csv.NewReader(strings.NewReader(brightnessCSV))
+	r.Comment = '#'
+	r.FieldsPerRecord = 13
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("brightness: %w", err)
+	}
+	table := make(map[string][12]string)
+	for i, rec := range records {
+		if i == 0 {
+			continue // header
+		}
+		var row [12]string
+		for b := range row {
+			v := strings.TrimSpace(rec[b+1])
+			switch v {
+			case "庙", "旺", "得", "利", "平", "不", "陷":
+				row[b] = v
+			case "-":
+			default:
+				return nil, fmt.Errorf("brightness: %s: unknown brightness %q", rec[0], v)
+			}
+		}
+		table[strings.TrimSpace(rec[0])] = row
+	}
+	return table, nil
+})
+
+// placeStars places the main and auxiliary stars and marks their brightness and 四化.
+func (p *Plate) placeStars() error {
+	brightness, err := loadBrightness()
+	if err != nil {
+		return err
+	}
+	put := func(name string, major bool, br bazi.Branch) {
+		s := Star{Name: name, Major: major, Brightness: brightness[name][br]}
+		for i, t := range transforms[p.Year.Stem] {
+			if t == name {
+				s.Transform = transformNames[i]
+			}
+		}
+		c := p.CellAt(br)
+		c.Stars = append(c.Stars, s)
+	}
+
+	zw := ziweiBranch(p.Lunar.Day, p.Bureau)
+	for _, s := range ziweiGroup {
+		put(s.name, true, bazi.Branch(mod(int(zw)+s.offset, 12)))
+	}
+	tf := mod(4-int(zw), 12) // mirrors 紫微 across the 寅申 axis
+	for _, s := range tianfuGroup {
+		put(s.name, true, bazi.Branch(mod(tf+s.offset, 12)))
+	}
+
+	m, h, ys := p.Month-1, int(p.Hour), p.Year.Stem
+	triad := mod(int(p.Year.Branch), 4)
+	lu := int(luCunBranch[ys])
+	put("左辅", false, bazi.Branch(mod(4+m, 12)))
+	put("右弼", false, bazi.Branch(mod(10-m, 12)))
+	put("文昌", false, bazi.Branch(mod(10-h, 12)))
+	put("文曲", false, bazi.Branch(mod(4+h, 12)))
+	put("天魁", false, kuiBranch[ys])
+	put("天钺", false, yueBranch[ys])
+	put("禄存", false, bazi.Branch(lu))
+	put("擎羊", false, bazi.Branch(mod(lu+1, 12)))
+	put("陀罗", false, bazi.Branch(mod(lu-1, 12)))
+	put("天马", false, tianMaBranch[triad])
+	put("火星", false, bazi.Branch(mod(int(huoStart[triad])+h, 12)))
+	put("铃星", false, bazi.Branch(mod(int(lingStart[triad])+h, 12)))
+	put("地空", false, bazi.Branch(mod(11-h, 12)))
+	put("地劫", false, bazi.Branch(mod(11+h, 12)))
+	return nil
+}